@@ -19,20 +19,38 @@
     (not (empty (include "vcluster.plugin.clusterRoleExtraRules" . )))
     (not (empty (include "vcluster.generic.clusterRoleExtraRules" . )))
     .Values.networking.replicateServices.fromHost
+    .Values.sync.fromHost.configMaps.enabled
+    .Values.sync.fromHost.secrets.enabled
     .Values.pro
     .Values.sync.toHost.storageClasses.enabled
     .Values.experimental.isolatedControlPlane.enabled
     .Values.sync.toHost.persistentVolumes.enabled
     .Values.sync.toHost.priorityClasses.enabled
+    .Values.sync.fromHost.priorityClasses.enabled
     .Values.sync.toHost.volumeSnapshots.enabled
     .Values.controlPlane.advanced.virtualScheduler.enabled
     .Values.sync.fromHost.ingressClasses.enabled
+    .Values.sync.fromHost.runtimeClasses.enabled
+    .Values.sync.fromHost.gatewayClasses.enabled
+    .Values.sync.toHost.gateways.enabled
+    .Values.sync.toHost.httpRoutes.enabled
+    .Values.sync.toHost.grpcRoutes.enabled
+    .Values.sync.toHost.referenceGrants.enabled
+    .Values.sync.fromHost.resourceClasses.enabled
+    .Values.sync.fromHost.resourceSlices.enabled
     (eq (toString .Values.sync.fromHost.storageClasses.enabled) "true")
     (eq (toString .Values.sync.fromHost.csiNodes.enabled) "true")
     (eq (toString .Values.sync.fromHost.csiDrivers.enabled) "true")
     (eq (toString .Values.sync.fromHost.csiStorageCapacities.enabled) "true")
     .Values.sync.fromHost.nodes.enabled
     .Values.integrations.kubeVirt.enabled
+    .Values.integrations.prometheusOperator.enabled
+    .Values.integrations.certManager.enabled
+    .Values.integrations.istio.enabled
+    .Values.integrations.externalSecrets.enabled
+    .Values.integrations.keda.enabled
+    .Values.sync.fromHost.customResourceDefinitions.enabled
+    .Values.sync.custom
     (and .Values.integrations.metricsServer.enabled .Values.integrations.metricsServer.nodes)
     .Values.experimental.multiNamespaceMode.enabled -}}
 {{- true -}}
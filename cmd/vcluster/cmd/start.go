@@ -100,6 +100,12 @@ func ExecuteStart(ctx context.Context, options *StartOptions) error {
 		return fmt.Errorf("create controller context: %w", err)
 	}
 
+	// load the name mappings on every replica, as all of them translate names
+	err = setup.LoadMappingsStore(controllerCtx)
+	if err != nil {
+		return fmt.Errorf("load mappings store: %w", err)
+	}
+
 	// start integrations
 	err = integrations.StartIntegrations(controllerCtx)
 	if err != nil {
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// CleanupCmd holds the cmd flags
+type CleanupCmd struct {
+	*flags.GlobalFlags
+	cli.CleanupOptions
+
+	Log log.Logger
+}
+
+// NewCleanupCmd creates a new command
+func NewCleanupCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &CleanupCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "cleanup" + util.VClusterNameOnlyUseLine,
+		Short: "Removes orphaned host objects of a virtual cluster",
+		Long: `#######################################################
+################## vcluster cleanup ###################
+#######################################################
+Cleanup removes objects in the host cluster that were
+synced by a virtual cluster, but whose virtual object
+does not exist anymore. If the virtual cluster itself
+was deleted, all of its synced host objects are only
+printed and are removed if --all is used.
+
+Use --dry-run to only print the objects that would be
+deleted.
+
+Example:
+vcluster cleanup test --namespace test --dry-run
+#######################################################
+	`,
+		Args: util.VClusterNameOnlyValidator,
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().BoolVar(&cmd.DryRun, "dry-run", false, "If enabled, only prints the objects that would be deleted")
+	cobraCmd.Flags().BoolVar(&cmd.All, "all", false, "If enabled, deletes all synced host objects of a virtual cluster that doesn't exist anymore")
+	cobraCmd.Flags().DurationVar(&cmd.GracePeriod, "grace-period", 0, "Only delete objects that are older than this duration")
+
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *CleanupCmd) Run(ctx context.Context, args []string) error {
+	return cli.CleanupHelm(ctx, cmd.GlobalFlags, &cmd.CleanupOptions, args[0], cmd.Log)
+}
@@ -83,6 +83,133 @@ type Integrations struct {
 
 	// KubeVirt reuses a host kubevirt and makes certain CRDs from it available inside the vCluster
 	KubeVirt KubeVirt `json:"kubeVirt,omitempty"`
+
+	// PrometheusOperator syncs Prometheus Operator monitoring resources from the vCluster to the host cluster
+	PrometheusOperator PrometheusOperator `json:"prometheusOperator,omitempty"`
+
+	// CertManager reuses a host cert-manager and makes its CRDs available inside the vCluster
+	CertManager CertManager `json:"certManager,omitempty"`
+
+	// Istio syncs Istio networking and security resources from the vCluster to the host cluster
+	Istio Istio `json:"istio,omitempty"`
+
+	// ExternalSecrets reuses a host External Secrets Operator and makes its CRDs available inside the vCluster
+	ExternalSecrets ExternalSecrets `json:"externalSecrets,omitempty"`
+
+	// KEDA reuses a host KEDA and makes its CRDs available inside the vCluster
+	KEDA KEDA `json:"keda,omitempty"`
+}
+
+// ExternalSecrets reuses a host External Secrets Operator and makes its CRDs available inside the vCluster. Secrets
+// are fetched in the host cluster and synced back into the vCluster.
+type ExternalSecrets struct {
+	// Enabled signals if the integration should be enabled
+	Enabled bool `json:"enabled,omitempty"`
+	// Sync holds configuration on what resources to sync
+	Sync ExternalSecretsSync `json:"sync,omitempty"`
+}
+
+// ExternalSecretsSync are the crds that are supported by this integration
+type ExternalSecretsSync struct {
+	// If ExternalSecrets should get synced
+	ExternalSecrets EnableSwitch `json:"externalSecrets,omitempty"`
+	// If SecretStores should get synced. ExternalSecrets can't use ClusterSecretStores of the host cluster.
+	Stores EnableSwitch `json:"stores,omitempty"`
+}
+
+// KEDA reuses a host KEDA and makes its CRDs available inside the vCluster
+type KEDA struct {
+	// Enabled signals if the integration should be enabled
+	Enabled bool `json:"enabled,omitempty"`
+	// Sync holds configuration on what resources to sync
+	Sync KEDASync `json:"sync,omitempty"`
+}
+
+// KEDASync are the crds that are supported by this integration
+type KEDASync struct {
+	// If ScaledObjects should get synced. The kind of the scale target needs to be synced to the host cluster, e.g. through sync.custom, so scaled objects targeting deployments or stateful sets are rejected.
+	ScaledObjects EnableSwitch `json:"scaledObjects,omitempty"`
+	// If TriggerAuthentications should get synced. ScaledObjects can't use ClusterTriggerAuthentications of the host cluster.
+	TriggerAuthentications EnableSwitch `json:"triggerAuthentications,omitempty"`
+}
+
+// Istio syncs Istio networking and security resources from the vCluster to the host cluster, so workloads of
+// the vCluster can use a host service mesh. Requires the Istio CRDs to be installed in the host cluster.
+type Istio struct {
+	// Enabled signals if the integration should be enabled
+	Enabled bool `json:"enabled,omitempty"`
+	// Sync holds configuration on what resources to sync
+	Sync IstioSync `json:"sync,omitempty"`
+}
+
+// IstioSync are the crds that are supported by this integration
+type IstioSync struct {
+	// If VirtualServices should get synced
+	VirtualServices EnableSwitch `json:"virtualServices,omitempty"`
+	// If DestinationRules should get synced
+	DestinationRules EnableSwitch `json:"destinationRules,omitempty"`
+	// If Gateways should get synced. Gateways can only select gateway pods synced by the vCluster.
+	Gateways EnableSwitch `json:"gateways,omitempty"`
+	// If PeerAuthentications should get synced. Policies only apply to pods synced by the vCluster.
+	PeerAuthentications EnableSwitch `json:"peerAuthentications,omitempty"`
+	// If AuthorizationPolicies should get synced. Policies only apply to pods synced by the vCluster.
+	AuthorizationPolicies EnableSwitch `json:"authorizationPolicies,omitempty"`
+}
+
+// CertManager reuses a host cert-manager and makes its CRDs available inside the vCluster. Certificates are issued
+// in the host cluster and the resulting tls secrets are synced back into the vCluster.
+type CertManager struct {
+	// Enabled signals if the integration should be enabled
+	Enabled bool `json:"enabled,omitempty"`
+	// Sync holds configuration on what resources to sync
+	Sync CertManagerSync `json:"sync,omitempty"`
+}
+
+// CertManagerSync are the crds that are supported by this integration
+type CertManagerSync struct {
+	// ToHost defines what resources should get synced from the vCluster to the host cluster
+	ToHost CertManagerSyncToHost `json:"toHost,omitempty"`
+	// FromHost defines what resources should get synced from the host cluster to the vCluster
+	FromHost CertManagerSyncFromHost `json:"fromHost,omitempty"`
+}
+
+type CertManagerSyncToHost struct {
+	// Certificates defines if certificates should get synced
+	Certificates EnableSwitch `json:"certificates,omitempty"`
+	// Issuers defines if issuers should get synced
+	Issuers EnableSwitch `json:"issuers,omitempty"`
+}
+
+type CertManagerSyncFromHost struct {
+	// ClusterIssuers defines if (and which) cluster issuers should get synced from the host cluster to the vCluster.
+	// Certificates can only use cluster issuers that are synced.
+	ClusterIssuers CertManagerClusterIssuers `json:"clusterIssuers,omitempty"`
+}
+
+type CertManagerClusterIssuers struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+	// Selector are the labels a host cluster issuer needs to have to get synced. If empty, all host cluster issuers are synced.
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// PrometheusOperator syncs Prometheus Operator monitoring resources from the vCluster to the host cluster. Requires the
+// Prometheus Operator CRDs to be installed in the host cluster.
+type PrometheusOperator struct {
+	// Enabled signals if the integration should be enabled
+	Enabled bool `json:"enabled,omitempty"`
+	// Sync holds configuration on what resources to sync
+	Sync PrometheusOperatorSync `json:"sync,omitempty"`
+}
+
+// PrometheusOperatorSync are the crds that are supported by this integration
+type PrometheusOperatorSync struct {
+	// If ServiceMonitors should get synced. Monitors can only select services synced by the vCluster.
+	ServiceMonitors EnableSwitch `json:"serviceMonitors,omitempty"`
+	// If PodMonitors should get synced. Monitors can only select pods synced by the vCluster.
+	PodMonitors EnableSwitch `json:"podMonitors,omitempty"`
+	// If PrometheusRules should get synced
+	PrometheusRules EnableSwitch `json:"prometheusRules,omitempty"`
 }
 
 // KubeVirt reuses a host kubevirt and makes certain CRDs from it available inside the vCluster
@@ -126,6 +253,24 @@ type MetricsServer struct {
 
 	// Pods defines if metrics-server pods api should get proxied from host to virtual cluster.
 	Pods bool `json:"pods,omitempty"`
+
+	// CustomMetrics proxies the custom metrics api (custom.metrics.k8s.io) of an adapter in the host cluster, such as
+	// prometheus-adapter, into the virtual cluster. This can be used independently of the metrics server.
+	CustomMetrics MetricsAPI `json:"customMetrics,omitempty"`
+
+	// ExternalMetrics proxies the external metrics api (external.metrics.k8s.io) of an adapter in the host cluster, such as
+	// KEDA, into the virtual cluster. This can be used independently of the metrics server.
+	ExternalMetrics MetricsAPI `json:"externalMetrics,omitempty"`
+}
+
+// MetricsAPI holds the configuration of a metrics api that is served by an adapter in the host cluster.
+type MetricsAPI struct {
+	// Enabled signals if the metrics api should get proxied from host to virtual cluster.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// APIService holds information about where to find the adapter service. Defaults to prometheus-adapter/monitoring
+	// for custom metrics and keda-operator-metrics-apiserver/keda for external metrics.
+	APIService APIService `json:"apiService,omitempty"`
 }
 
 // APIService holds configuration related to the api server
@@ -337,6 +482,160 @@ type Sync struct {
 
 	// Configure what resources vCluster should sync from the host cluster to the virtual cluster.
 	FromHost SyncFromHost `json:"fromHost,omitempty"`
+
+	// Custom syncs arbitrary resources between the virtual and the host cluster. Each entry declares which fields are owned
+	// by which cluster, so fields written by controllers on the other side are synced back instead of being overwritten. The
+	// chart grants vCluster access to all host resources of each configured API group.
+	Custom []SyncCustom `json:"custom,omitempty"`
+
+	// HostNaming defines how synced objects are named within the host cluster. Only used if multi namespace mode is disabled.
+	HostNaming SyncHostNaming `json:"hostNaming,omitempty"`
+
+	// Controllers defines the concurrency and rate limiting of the syncer controllers.
+	Controllers SyncControllers `json:"controllers,omitempty"`
+
+	// GarbageCollection defines if host objects whose virtual object does not exist anymore should get removed periodically.
+	GarbageCollection SyncGarbageCollection `json:"garbageCollection,omitempty"`
+}
+
+type SyncCustom struct {
+	// APIVersion of the synced objects, e.g. example.com/v1.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the synced objects.
+	Kind string `json:"kind,omitempty"`
+
+	// Direction is either "toHost", which syncs objects from the virtual cluster to the host, or "fromHost", which syncs
+	// objects from the host into the virtual cluster and requires the multi-namespace mode. Defaults to toHost.
+	Direction string `json:"direction,omitempty"`
+
+	// Optional skips the resource instead of failing if its custom resource definition does not exist in the host cluster.
+	Optional bool `json:"optional,omitempty"`
+
+	// ReplaceWhenInvalid recreates the target object if applying it fails because it is invalid.
+	ReplaceWhenInvalid bool `json:"replaceWhenInvalid,omitempty"`
+
+	// Selector are the labels a virtual object needs to have to get synced. Only supported for toHost.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// Patches are applied to the synced object before it is applied to the target cluster.
+	Patches []*Patch `json:"patches,omitempty"`
+
+	// Ownership declares which fields are owned by which cluster.
+	Ownership SyncCustomOwnership `json:"ownership,omitempty"`
+}
+
+// SyncCustomOwnership declares the fields the target cluster of a custom sync owns. All other fields are owned by the source
+// cluster. Owned fields are dot separated paths, e.g. status or spec.replicas.
+type SyncCustomOwnership struct {
+	// Host are the fields owned by the host cluster, which are synced back into the virtual object and never applied to the
+	// host object. The status is always owned by the host. Only supported for toHost.
+	Host []string `json:"host,omitempty"`
+
+	// Virtual are the fields owned by the virtual cluster, which are synced back into the host object and never applied to the
+	// virtual object. Only supported for fromHost.
+	Virtual []string `json:"virtual,omitempty"`
+}
+
+type SyncGarbageCollection struct {
+	// Enabled defines if orphaned host objects should get garbage collected.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// DryRun only reports orphaned host objects in the logs instead of deleting them.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Interval defines how often the host cluster is checked for orphaned objects, e.g. 10m.
+	Interval string `json:"interval,omitempty"`
+
+	// GracePeriod is the minimum age of a host object before it is considered orphaned, e.g. 1h.
+	GracePeriod string `json:"gracePeriod,omitempty"`
+}
+
+type SyncControllers struct {
+	// Default are the settings used by all syncer controllers that have no override configured.
+	Default SyncController `json:"default,omitempty"`
+
+	// Overrides are syncer specific settings keyed by the syncer name (e.g. pod, service or ingressclass), which is also used
+	// as controller label in the workqueue metrics. Options that are not set in an override fall back to the default.
+	Overrides map[string]SyncController `json:"overrides,omitempty"`
+}
+
+type SyncController struct {
+	// Workers is the maximum number of objects that are reconciled concurrently.
+	Workers int `json:"workers,omitempty"`
+
+	// RateLimiter defines how fast failed objects are retried and how fast objects are processed overall.
+	RateLimiter SyncControllerRateLimiter `json:"rateLimiter,omitempty"`
+
+	// ConflictPolicy defines what happens if a synced host object was changed outside of vCluster since the last sync.
+	// Can be "revert" (overwrite the host changes), "adopt-into-virtual" (copy the host changes into the virtual object
+	// where the syncer supports it), "ignore" (keep the host changes until the virtual object changes) or "warn" (like
+	// ignore, but records a warning event on the virtual object). Drift events are recorded for all policies except ignore.
+	// If empty, drift is not detected.
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+
+	// Shadow runs the syncer without writing the objects it syncs. Changes are only validated through a server side dry run,
+	// logged and exposed at the /debug/vcluster/shadow endpoint of the host metrics listener, which is only served if
+	// experimental.syncSettings.hostMetricsBindAddress is set. Shadow mode is best-effort: events and objects that syncers
+	// write through their own clients, such as service account token secrets, are still written. An override can set
+	// this to false to opt a single syncer out of a shadow default.
+	Shadow *bool `json:"shadow,omitempty"`
+
+	// Selector limits which virtual objects get synced to the host cluster. Host objects that were already synced
+	// are left untouched if their virtual object does not match anymore.
+	Selector SyncControllerSelector `json:"selector,omitempty"`
+}
+
+type SyncControllerSelector struct {
+	// Labels are the labels a virtual object needs to have to get synced, e.g. expose: "true".
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// MatchExpressions are additional label requirements a virtual object needs to fulfill to get synced.
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+
+	// Namespaces are glob patterns of the virtual namespaces to sync objects from, e.g. "team-*". If empty,
+	// objects from all namespaces are synced. Cluster scoped objects are not affected.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ExcludedNamespaces are glob patterns of the virtual namespaces to not sync objects from, e.g. "sandbox-*".
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+type ConflictPolicy string
+
+const (
+	ConflictPolicyRevert           ConflictPolicy = "revert"
+	ConflictPolicyAdoptIntoVirtual ConflictPolicy = "adopt-into-virtual"
+	ConflictPolicyIgnore           ConflictPolicy = "ignore"
+	ConflictPolicyWarn             ConflictPolicy = "warn"
+)
+
+type SyncControllerRateLimiter struct {
+	// BaseDelay is the initial delay before a failed object is retried, e.g. 5ms.
+	BaseDelay string `json:"baseDelay,omitempty"`
+
+	// MaxDelay is the maximum delay before a failed object is retried, e.g. 1000s.
+	MaxDelay string `json:"maxDelay,omitempty"`
+
+	// QPS is the overall number of objects that can be processed per second.
+	QPS int `json:"qps,omitempty"`
+
+	// Burst is the number of objects that can be processed at once above the QPS.
+	Burst int `json:"burst,omitempty"`
+}
+
+type SyncHostNaming struct {
+	// Namespaced is the Go template used to build the host name of namespaced objects, e.g. "{{.Namespace}}--{{.Name}}".
+	// Available fields are .Name, .Namespace, .VClusterName and .TargetNamespace. A hash of the virtual name and namespace
+	// is always appended to keep host names unique and the result is cut to 63 characters. Rendered names need to be valid
+	// DNS-1123 names. If empty, the default "{{.Name}}-x-{{.Namespace}}-x-{{.VClusterName}}" naming is used.
+	Namespaced string `json:"namespaced,omitempty"`
+
+	// ClusterScoped is the Go template used to build the host name of cluster scoped objects, e.g. "{{.VClusterName}}--{{.Name}}".
+	// Available fields are .Name, .VClusterName and .TargetNamespace. A hash of the virtual name is always appended to keep
+	// host names unique and the result is cut to 253 characters.
+	// If empty, the default "vcluster-{{.Name}}-x-{{.TargetNamespace}}-x-{{.VClusterName}}" naming is used.
+	ClusterScoped string `json:"clusterScoped,omitempty"`
 }
 
 type SyncToHost struct {
@@ -350,7 +649,7 @@ type SyncToHost struct {
 	ConfigMaps SyncAllResource `json:"configMaps,omitempty"`
 
 	// Ingresses defines if ingresses created within the virtual cluster should get synced to the host cluster.
-	Ingresses EnableSwitch `json:"ingresses,omitempty"`
+	Ingresses SyncToHostIngresses `json:"ingresses,omitempty"`
 
 	// Services defines if services created within the virtual cluster should get synced to the host cluster.
 	Services EnableSwitch `json:"services,omitempty"`
@@ -358,11 +657,15 @@ type SyncToHost struct {
 	// Endpoints defines if endpoints created within the virtual cluster should get synced to the host cluster.
 	Endpoints EnableSwitch `json:"endpoints,omitempty"`
 
+	// EndpointSlices defines if endpoint slices created within the virtual cluster should get synced to the host cluster.
+	// Endpoint slices managed by the Kubernetes endpoint slice controllers are not synced, as the host cluster creates these itself.
+	EndpointSlices EnableSwitch `json:"endpointSlices,omitempty"`
+
 	// NetworkPolicies defines if network policies created within the virtual cluster should get synced to the host cluster.
 	NetworkPolicies EnableSwitch `json:"networkPolicies,omitempty"`
 
 	// PersistentVolumeClaims defines if persistent volume claims created within the virtual cluster should get synced to the host cluster.
-	PersistentVolumeClaims EnableSwitch `json:"persistentVolumeClaims,omitempty"`
+	PersistentVolumeClaims SyncToHostPersistentVolumeClaims `json:"persistentVolumeClaims,omitempty"`
 
 	// PersistentVolumes defines if persistent volumes created within the virtual cluster should get synced to the host cluster.
 	PersistentVolumes EnableSwitch `json:"persistentVolumes,omitempty"`
@@ -381,6 +684,26 @@ type SyncToHost struct {
 
 	// PriorityClasses defines if priority classes created within the virtual cluster should get synced to the host cluster.
 	PriorityClasses EnableSwitch `json:"priorityClasses,omitempty"`
+
+	// Gateways defines if Gateway API gateways created within the virtual cluster should get synced to the host cluster.
+	// Requires the Gateway API CRDs to be installed in the host cluster.
+	Gateways EnableSwitch `json:"gateways,omitempty"`
+
+	// HTTPRoutes defines if Gateway API http routes created within the virtual cluster should get synced to the host cluster.
+	HTTPRoutes EnableSwitch `json:"httpRoutes,omitempty"`
+
+	// GRPCRoutes defines if Gateway API grpc routes created within the virtual cluster should get synced to the host cluster.
+	GRPCRoutes EnableSwitch `json:"grpcRoutes,omitempty"`
+
+	// ReferenceGrants defines if Gateway API reference grants created within the virtual cluster should get synced to the host cluster.
+	ReferenceGrants EnableSwitch `json:"referenceGrants,omitempty"`
+
+	// ResourceClaims defines if dynamic resource allocation claims created within the virtual cluster should get synced to the host cluster.
+	// Requires the DynamicResourceAllocation feature gate and the resource.k8s.io/v1alpha2 API in the host and virtual cluster.
+	ResourceClaims EnableSwitch `json:"resourceClaims,omitempty"`
+
+	// ResourceClaimTemplates defines if dynamic resource allocation claim templates created within the virtual cluster should get synced to the host cluster.
+	ResourceClaimTemplates EnableSwitch `json:"resourceClaimTemplates,omitempty"`
 }
 
 type SyncFromHost struct {
@@ -393,9 +716,32 @@ type SyncFromHost struct {
 	// IngressClasses defines if ingress classes should get synced from the host cluster to the virtual cluster, but not back.
 	IngressClasses EnableSwitch `json:"ingressClasses,omitempty"`
 
+	// GatewayClasses defines if Gateway API gateway classes should get synced from the host cluster to the virtual cluster, but not back.
+	GatewayClasses EnableSwitch `json:"gatewayClasses,omitempty"`
+
+	// PriorityClasses defines if mapped host priority classes should get synced from the host cluster to the virtual cluster, but not back.
+	PriorityClasses SyncFromHostPriorityClasses `json:"priorityClasses,omitempty"`
+
+	// RuntimeClasses defines if runtime classes should get synced from the host cluster to the virtual cluster, but not back.
+	RuntimeClasses SyncRuntimeClasses `json:"runtimeClasses,omitempty"`
+
+	// ResourceClasses defines if dynamic resource allocation classes should get synced from the host cluster to the virtual cluster, but not back.
+	ResourceClasses EnableSwitch `json:"resourceClasses,omitempty"`
+
+	// ResourceSlices defines if dynamic resource allocation slices published by the host drivers should get synced from the host cluster to the virtual cluster, but not back.
+	ResourceSlices EnableSwitch `json:"resourceSlices,omitempty"`
+
 	// StorageClasses defines if storage classes should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
 	StorageClasses EnableAutoSwitch `json:"storageClasses,omitempty"`
 
+	// ConfigMaps defines which config maps should get imported from host namespaces into the virtual cluster. Imported
+	// config maps are kept in sync with the host and cannot be changed within the virtual cluster.
+	ConfigMaps SyncFromHostObjects `json:"configMaps,omitempty"`
+
+	// Secrets defines which secrets should get imported from host namespaces into the virtual cluster. Imported
+	// secrets are kept in sync with the host and cannot be changed within the virtual cluster.
+	Secrets SyncFromHostObjects `json:"secrets,omitempty"`
+
 	// CSINodes defines if csi nodes should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
 	CSINodes EnableAutoSwitch `json:"csiNodes,omitempty"`
 
@@ -404,6 +750,24 @@ type SyncFromHost struct {
 
 	// CSIStorageCapacities defines if csi storage capacities should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
 	CSIStorageCapacities EnableAutoSwitch `json:"csiStorageCapacities,omitempty"`
+
+	// CustomResourceDefinitions defines if host custom resource definitions with a matching label should get mirrored into
+	// the virtual cluster. Mirrored custom resource definitions are kept up to date as their versions change in the host cluster.
+	CustomResourceDefinitions SyncFromHostCustomResourceDefinitions `json:"customResourceDefinitions,omitempty"`
+}
+
+type SyncFromHostCustomResourceDefinitions struct {
+	// Enabled defines if host custom resource definitions should get mirrored into the virtual cluster.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Selector are the labels a host custom resource definition needs to have to get mirrored.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// GenericSync optionally starts a generic syncer for the objects of each mirrored custom resource definition. Can be
+	// either "export", which syncs objects from the virtual cluster to the host, or "import", which syncs objects from the
+	// host into the virtual cluster and requires the multi-namespace mode. Leave empty to only mirror the definitions. The
+	// host permissions for the synced objects need to be granted through rbac.role.extraRules.
+	GenericSync string `json:"genericSync,omitempty"`
 }
 
 type EnableAutoSwitch struct {
@@ -461,6 +825,81 @@ type SyncRewriteHostsInitContainer struct {
 	Resources Resources `json:"resources,omitempty"`
 }
 
+type SyncFromHostPriorityClasses struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Mappings maps priority class names within the virtual cluster to host priority class names. Only mapped host
+	// priority classes are synced into the virtual cluster under their virtual name and pods that use a priority class
+	// that is not mapped won't get synced to the host cluster. Built-in system priority classes cannot be mapped and are
+	// removed from synced pods.
+	Mappings map[string]string `json:"mappings,omitempty"`
+
+	// MaxValue is the maximum value of the synced priority classes within the virtual cluster. Higher host values are clamped
+	// to this value. If 0, 1000000000 is used.
+	MaxValue int32 `json:"maxValue,omitempty"`
+}
+
+type SyncFromHostObjects struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Mappings defines which host objects should get imported into which virtual objects.
+	Mappings []FromHostObjectMapping `json:"mappings,omitempty"`
+}
+
+type FromHostObjectMapping struct {
+	// From is the host object in the form namespace/name. If only a namespace is given, all objects within that namespace
+	// that match the selector are imported.
+	From string `json:"from,omitempty"`
+
+	// Selector are the labels a host object needs to have to get imported. Can only be used if From is a namespace.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// To is the virtual object in the form namespace/name. If From is a namespace, To is the virtual namespace
+	// the objects are imported into under their host name.
+	To string `json:"to,omitempty"`
+}
+
+type SyncToHostIngresses struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IngressClasses maps ingress classes used within the virtual cluster to host ingress classes. The mapping is applied
+	// to the ingress class name as well as the legacy kubernetes.io/ingress.class annotation.
+	IngressClasses ClassMappings `json:"ingressClasses,omitempty"`
+}
+
+type SyncToHostPersistentVolumeClaims struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// StorageClasses maps storage classes used within the virtual cluster to host storage classes. The mapping is applied
+	// to persistent volume claims as well as persistent volumes.
+	StorageClasses ClassMappings `json:"storageClasses,omitempty"`
+}
+
+type ClassMappings struct {
+	// Mappings maps class names within the virtual cluster to class names within the host cluster, e.g. "standard: gp3".
+	Mappings map[string]string `json:"mappings,omitempty"`
+
+	// Default is the host class that is used for objects that don't specify a class within the virtual cluster. If empty,
+	// the host cluster default class is used.
+	Default string `json:"default,omitempty"`
+
+	// RejectUnmapped defines if objects that use a class that is not mapped should not get synced to the host cluster.
+	RejectUnmapped bool `json:"rejectUnmapped,omitempty"`
+}
+
+type SyncRuntimeClasses struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Allowed is a list of host runtime classes that should get synced into the virtual cluster. If empty, all host
+	// runtime classes are synced. Pods that use a runtime class that is not allowed won't get synced to the host cluster.
+	Allowed []string `json:"allowed,omitempty"`
+}
+
 type SyncNodes struct {
 	// Enabled specifies if syncing real nodes should be enabled. If this is disabled, vCluster will create fake nodes instead.
 	Enabled bool `json:"enabled,omitempty"`
@@ -1656,6 +2095,7 @@ type Experimental struct {
 	SyncSettings ExperimentalSyncSettings `json:"syncSettings,omitempty"`
 
 	// GenericSync holds options to generically sync resources from virtual cluster to host.
+	// Deprecated: use sync.custom instead, which declares the field ownership of each direction.
 	GenericSync ExperimentalGenericSync `json:"genericSync,omitempty"`
 
 	// MultiNamespaceMode tells virtual cluster to sync to multiple namespaces instead of a single one. This will map each virtual cluster namespace to a single namespace in the host cluster.
@@ -1912,6 +2352,11 @@ type Patch struct {
 	// Value is the new value to be set to the path
 	Value interface{} `json:"value,omitempty" yaml:"value,omitempty"`
 
+	// Expression is a CEL expression whose result is set to the path by the expression operation. The expression can access
+	// the patched object as object, the object it is synced from as sourceObject and the current value of the path as value.
+	// The latter two are null if they don't exist. If the expression returns null, the path is removed.
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
+
 	// Regex - is regular expresion used to identify the Name,
 	// and optionally Namespace, parts of the field value that
 	// will be replaced with the rewritten Name and/or Namespace
@@ -1942,6 +2387,7 @@ const (
 	PatchTypeAdd            PatchType = "add"
 	PatchTypeReplace        PatchType = "replace"
 	PatchTypeRemove         PatchType = "remove"
+	PatchTypeExpression     PatchType = "expression"
 )
 
 type PatchCondition struct {
@@ -1959,6 +2405,11 @@ type PatchCondition struct {
 
 	// Empty means that the path value should be empty or unset
 	Empty *bool `json:"empty,omitempty" yaml:"empty,omitempty"`
+
+	// Expression is a CEL expression that needs to return true for the patch to get applied. It is evaluated once per patch
+	// and can access the patched object as object and the object it is synced from as sourceObject, which is null if it
+	// doesn't exist. Cannot be combined with the other condition fields.
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
 }
 
 type PatchSync struct {
@@ -30,10 +30,13 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
-func checkExistingAPIService(ctx context.Context, client client.Client, groupVersion schema.GroupVersion) bool {
+// checkExistingAPIService checks if the api service for the given group version exists and is served by the given
+// service. This makes sure api services of the same group that were installed by the user are not touched.
+func checkExistingAPIService(ctx context.Context, client client.Client, serviceName string, groupVersion schema.GroupVersion) bool {
 	var exists bool
 	_ = applyOperation(ctx, func(ctx context.Context) (bool, error) {
-		err := client.Get(ctx, types.NamespacedName{Name: groupVersion.Version + "." + groupVersion.Group}, &apiregistrationv1.APIService{})
+		apiService := &apiregistrationv1.APIService{}
+		err := client.Get(ctx, types.NamespacedName{Name: groupVersion.Version + "." + groupVersion.Group}, apiService)
 		if err != nil {
 			if kerrors.IsNotFound(err) {
 				return true, nil
@@ -42,7 +45,7 @@ func checkExistingAPIService(ctx context.Context, client client.Client, groupVer
 			return false, err
 		}
 
-		exists = true
+		exists = apiService.Spec.Service != nil && apiService.Spec.Service.Name == serviceName && apiService.Spec.Service.Namespace == "kube-system"
 		return true, nil
 	})
 
@@ -235,9 +238,9 @@ func RegisterAPIService(ctx *synccontext.ControllerContext, serviceName string,
 	return applyOperation(ctx, createOperation(ctx, serviceName, hostPort, groupVersion))
 }
 
-func DeregisterAPIService(ctx *synccontext.ControllerContext, groupVersion schema.GroupVersion) error {
-	// check if the api service should get created
-	exists := checkExistingAPIService(ctx, ctx.VirtualManager.GetClient(), groupVersion)
+func DeregisterAPIService(ctx *synccontext.ControllerContext, serviceName string, groupVersion schema.GroupVersion) error {
+	// check if the api service should get deleted
+	exists := checkExistingAPIService(ctx, ctx.VirtualManager.GetClient(), serviceName, groupVersion)
 	if exists {
 		return applyOperation(ctx, deleteOperation(ctx, groupVersion))
 	}
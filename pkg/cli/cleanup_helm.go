@@ -0,0 +1,285 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/loft-sh/log"
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/garbagecollector"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	mapperresources "github.com/loft-sh/vcluster/pkg/mappings/resources"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/yaml"
+)
+
+type CleanupOptions struct {
+	DryRun      bool
+	All         bool
+	GracePeriod time.Duration
+}
+
+// CleanupHelm removes host objects that were synced by a virtual cluster, but whose virtual object does not exist
+// anymore. If the virtual cluster was deleted, all of its managed host objects are removed.
+func CleanupHelm(ctx context.Context, globalFlags *flags.GlobalFlags, options *CleanupOptions, vClusterName string, log log.Logger) error {
+	// load the kube config
+	kubeClientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{
+		CurrentContext: globalFlags.Context,
+	})
+	restConfig, err := kubeClientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("there is an error loading your current kube config (%w), please make sure you have access to a kubernetes cluster and the command `kubectl get namespaces` is working", err)
+	}
+	namespace := globalFlags.Namespace
+	if namespace == "" {
+		namespace, _, err = kubeClientConfig.Namespace()
+		if err != nil {
+			return err
+		}
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	hostClient, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return err
+	}
+
+	// find out which host namespace(s) the virtual cluster syncs to
+	vConfig, err := getCleanupVClusterConfig(ctx, kubeClient, vClusterName, namespace, log)
+	if err != nil {
+		return err
+	}
+	translate.VClusterName = vClusterName
+	err = translate.InitDefault(vConfig)
+	if err != nil {
+		return err
+	}
+
+	garbageCollector := &garbagecollector.GarbageCollector{
+		SyncContext: &synccontext.SyncContext{
+			Context:        ctx,
+			Log:            loghelper.NewFromExisting(logr.New(log.LogrLogSink()), "cleanup"),
+			Config:         vConfig,
+			PhysicalClient: hostClient,
+		},
+
+		HostReader: hostClient,
+		HostClient: hostClient,
+		HostMapper: hostClient.RESTMapper(),
+
+		HostNamespace: garbagecollector.HostNamespace(vConfig),
+		DryRun:        options.DryRun,
+		GracePeriod:   options.GracePeriod,
+	}
+
+	// without a virtual cluster every managed host object is orphaned, otherwise check the virtual objects
+	var orphans []*metav1.PartialObjectMetadata
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, namespace, log)
+	if err != nil {
+		var errorNotFound *find.VClusterNotFoundError
+		if !errors.As(err, &errorNotFound) {
+			return err
+		}
+
+		gvks, err := discoverCleanupKinds(restConfig)
+		if err != nil {
+			return err
+		}
+
+		// a mistyped name would otherwise delete everything, so only print the objects unless --all is used
+		if !options.All && !options.DryRun {
+			log.Warnf("Couldn't find virtual cluster %s/%s, so all of its synced host objects are considered orphaned", namespace, vClusterName)
+			garbageCollector.DryRun = true
+			orphans = garbageCollector.CollectKinds(gvks)
+			if len(orphans) == 0 {
+				log.Donef("Found no synced host objects of virtual cluster %s/%s", namespace, vClusterName)
+				return nil
+			}
+
+			return fmt.Errorf("virtual cluster %s/%s doesn't exist, please run the command again with --all to delete the %d host objects listed above", namespace, vClusterName, len(orphans))
+		}
+
+		orphans = garbageCollector.CollectKinds(gvks)
+	} else {
+		if vCluster.Status == find.StatusPaused {
+			return fmt.Errorf("virtual cluster %s is paused, please resume it before cleaning up", vClusterName)
+		}
+
+		virtualConfig, stop, err := (&connectHelm{
+			GlobalFlags:    globalFlags,
+			ConnectOptions: &ConnectOptions{},
+			Log:            log,
+		}).localVClusterRestConfig(ctx, vCluster)
+		if err != nil {
+			return err
+		}
+		defer stop()
+
+		managerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		registerCtx, err := startCleanupManagers(managerCtx, vConfig, restConfig, virtualConfig, hostClient, log)
+		if err != nil {
+			return err
+		}
+
+		garbageCollector.SyncContext.Mappings = registerCtx.Mappings
+		garbageCollector.SyncContext.VirtualClient = registerCtx.VirtualManager.GetClient()
+		garbageCollector.VirtualReader = registerCtx.VirtualManager.GetAPIReader()
+		orphans = garbageCollector.Collect()
+	}
+
+	if options.DryRun {
+		log.Donef("Found %d orphaned objects of virtual cluster %s/%s", len(orphans), namespace, vClusterName)
+		return nil
+	}
+
+	log.Donef("Successfully cleaned up %d orphaned objects of virtual cluster %s/%s", len(orphans), namespace, vClusterName)
+	return nil
+}
+
+// getCleanupVClusterConfig loads the config of the virtual cluster from its config secret. If the secret does not
+// exist anymore, the default config is used.
+func getCleanupVClusterConfig(ctx context.Context, kubeClient kubernetes.Interface, vClusterName, namespace string, log log.Logger) (*config.VirtualClusterConfig, error) {
+	rawConfig, err := vclusterconfig.NewDefaultConfig()
+	if err != nil {
+		return nil, fmt.Errorf("create default config: %w", err)
+	}
+
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+vClusterName, metav1.GetOptions{})
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("get config secret: %w", err)
+		}
+
+		log.Warnf("Couldn't find the config of virtual cluster %s/%s, assuming the default config", namespace, vClusterName)
+	} else {
+		err = yaml.Unmarshal(secret.Data["config.yaml"], rawConfig)
+		if err != nil {
+			return nil, fmt.Errorf("parse config of virtual cluster %s/%s: %w", namespace, vClusterName, err)
+		}
+	}
+
+	vConfig := &config.VirtualClusterConfig{
+		Config:                *rawConfig,
+		Name:                  vClusterName,
+		ControlPlaneService:   vClusterName,
+		ControlPlaneNamespace: namespace,
+		WorkloadService:       vClusterName,
+		WorkloadNamespace:     namespace,
+	}
+	err = config.ValidateConfigAndSetDefaults(vConfig)
+	if err != nil {
+		return nil, fmt.Errorf("validate config of virtual cluster %s/%s: %w", namespace, vClusterName, err)
+	}
+
+	return vConfig, nil
+}
+
+// startCleanupManagers registers the mappers of the virtual cluster and waits until the caches they need are synced
+func startCleanupManagers(ctx context.Context, vConfig *config.VirtualClusterConfig, hostConfig, virtualConfig *rest.Config, hostClient client.Client, log log.Logger) (*synccontext.RegisterContext, error) {
+	hostCacheOptions := cache.Options{}
+	if hostNamespace := garbagecollector.HostNamespace(vConfig); hostNamespace != "" {
+		hostCacheOptions.DefaultNamespaces = map[string]cache.Config{hostNamespace: {}}
+	}
+	hostManager, err := ctrl.NewManager(hostConfig, ctrl.Options{
+		Scheme:  scheme.Scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		Cache:   hostCacheOptions,
+		Logger:  logr.New(log.LogrLogSink()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create host manager: %w", err)
+	}
+	virtualManager, err := ctrl.NewManager(virtualConfig, ctrl.Options{
+		Scheme:  scheme.Scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		Logger:  logr.New(log.LogrLogSink()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create virtual manager: %w", err)
+	}
+
+	registerCtx := &synccontext.RegisterContext{
+		Context: ctx,
+		Config:  vConfig,
+
+		CurrentNamespace:       vConfig.WorkloadNamespace,
+		CurrentNamespaceClient: hostClient,
+
+		Mappings: mappings.NewMappingsRegistry(nil),
+
+		VirtualManager:  virtualManager,
+		PhysicalManager: hostManager,
+	}
+	err = mapperresources.RegisterMappings(registerCtx)
+	if err != nil {
+		return nil, fmt.Errorf("register resource mappings: %w", err)
+	}
+
+	for _, manager := range []ctrl.Manager{hostManager, virtualManager} {
+		go func(manager ctrl.Manager) {
+			err := manager.Start(ctx)
+			if err != nil {
+				log.Fatalf("start manager: %v", err)
+			}
+		}(manager)
+	}
+	hostManager.GetCache().WaitForCacheSync(ctx)
+	virtualManager.GetCache().WaitForCacheSync(ctx)
+	return registerCtx, nil
+}
+
+// discoverCleanupKinds returns all kinds of the host cluster that could contain managed objects
+func discoverCleanupKinds(restConfig *rest.Config) ([]schema.GroupVersionKind, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("discover server resources: %w", err)
+	}
+	resourceLists = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, resourceLists)
+
+	gvks := []schema.GroupVersionKind{}
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			gvks = append(gvks, groupVersion.WithKind(resource.Kind))
+		}
+	}
+
+	return gvks, nil
+}
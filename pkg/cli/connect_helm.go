@@ -97,7 +97,7 @@ func (cmd *connectHelm) connect(ctx context.Context, vCluster *find.VCluster, co
 	}
 
 	// retrieve vcluster kube config
-	kubeConfig, err := cmd.getVClusterKubeConfig(ctx, vCluster.Name, command)
+	kubeConfig, err := cmd.getVClusterKubeConfig(ctx, vCluster.Name, len(command) > 0)
 	if err != nil {
 		return err
 	}
@@ -221,6 +221,38 @@ func writeKubeConfig(kubeConfig *clientcmdapi.Config, vClusterName string, optio
 	return nil
 }
 
+// localVClusterRestConfig port-forwards to the vCluster and returns a rest config to access it from within this
+// process. The returned func stops the port-forwarding.
+func (cmd *connectHelm) localVClusterRestConfig(ctx context.Context, vCluster *find.VCluster) (*rest.Config, func(), error) {
+	err := cmd.prepare(ctx, vCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubeConfig, err := cmd.getVClusterKubeConfig(ctx, vCluster.Name, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	stop := func() {
+		close(cmd.interruptChan)
+	}
+
+	// wait for vcluster to be ready
+	err = cmd.waitForVCluster(ctx, *kubeConfig, cmd.errorChan)
+	if err != nil {
+		stop()
+		return nil, nil, err
+	}
+
+	restConfig, err := clientcmd.NewDefaultClientConfig(getLocalVClusterConfig(*kubeConfig, cmd.ConnectOptions), &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		stop()
+		return nil, nil, fmt.Errorf("create virtual rest config: %w", err)
+	}
+
+	return restConfig, stop, nil
+}
+
 func (cmd *connectHelm) prepare(ctx context.Context, vCluster *find.VCluster) error {
 	if cmd.LocalPort == 0 {
 		cmd.LocalPort = clihelper.RandomPort()
@@ -272,7 +304,9 @@ func (cmd *connectHelm) prepare(ctx context.Context, vCluster *find.VCluster) er
 	return nil
 }
 
-func (cmd *connectHelm) getVClusterKubeConfig(ctx context.Context, vclusterName string, command []string) (*clientcmdapi.Config, error) {
+// getVClusterKubeConfig returns the kube config of the vCluster. If localOnly is set, the vCluster is only accessed by
+// this process, which means port-forwarding is always used and runs quietly.
+func (cmd *connectHelm) getVClusterKubeConfig(ctx context.Context, vclusterName string, localOnly bool) (*clientcmdapi.Config, error) {
 	var err error
 	podName := cmd.PodName
 	if podName == "" {
@@ -328,7 +362,7 @@ func (cmd *connectHelm) getVClusterKubeConfig(ctx context.Context, vclusterName
 	}
 
 	// check if the vcluster is exposed and set server
-	if vclusterName != "" && cmd.Server == "" && len(command) == 0 {
+	if vclusterName != "" && cmd.Server == "" && !localOnly {
 		err = cmd.setServerIfExposed(ctx, vclusterName, kubeConfig)
 		if err != nil {
 			return nil, err
@@ -381,15 +415,15 @@ func (cmd *connectHelm) getVClusterKubeConfig(ctx context.Context, vclusterName
 	}
 
 	// start port forwarding
-	if cmd.ServiceAccount != "" || cmd.Server == "" || len(command) > 0 {
+	if cmd.ServiceAccount != "" || cmd.Server == "" || localOnly {
 		cmd.portForwarding = true
 		cmd.interruptChan = make(chan struct{})
 		cmd.errorChan = make(chan error)
 
-		// silence port-forwarding if a command is used
+		// silence port-forwarding if the vCluster is only accessed locally
 		stdout := io.Writer(os.Stdout)
 		stderr := io.Writer(os.Stderr)
-		if len(command) > 0 || cmd.BackgroundProxy {
+		if localOnly || cmd.BackgroundProxy {
 			stdout = io.Discard
 			stderr = io.Discard
 		}
@@ -1,16 +1,19 @@
 package config
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/config/legacyconfig"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -106,6 +109,67 @@ func (v VirtualClusterConfig) VirtualClusterKubeConfig() config.VirtualClusterKu
 	return retConfig
 }
 
+// ShadowEnabled returns true if at least one syncer runs in shadow mode
+func (v VirtualClusterConfig) ShadowEnabled() bool {
+	if ptr.Deref(v.Sync.Controllers.Default.Shadow, false) {
+		return true
+	}
+	for _, override := range v.Sync.Controllers.Overrides {
+		if ptr.Deref(override.Shadow, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SyncController returns the controller settings for the syncer with the given name
+func (v VirtualClusterConfig) SyncController(name string) config.SyncController {
+	retConfig := v.Sync.Controllers.Overrides[name]
+	if retConfig.Workers == 0 {
+		retConfig.Workers = v.Sync.Controllers.Default.Workers
+	}
+	if retConfig.RateLimiter.BaseDelay == "" {
+		retConfig.RateLimiter.BaseDelay = v.Sync.Controllers.Default.RateLimiter.BaseDelay
+	}
+	if retConfig.RateLimiter.MaxDelay == "" {
+		retConfig.RateLimiter.MaxDelay = v.Sync.Controllers.Default.RateLimiter.MaxDelay
+	}
+	if retConfig.RateLimiter.QPS == 0 {
+		retConfig.RateLimiter.QPS = v.Sync.Controllers.Default.RateLimiter.QPS
+	}
+	if retConfig.RateLimiter.Burst == 0 {
+		retConfig.RateLimiter.Burst = v.Sync.Controllers.Default.RateLimiter.Burst
+	}
+	if retConfig.ConflictPolicy == "" {
+		retConfig.ConflictPolicy = v.Sync.Controllers.Default.ConflictPolicy
+	}
+	if retConfig.Shadow == nil {
+		retConfig.Shadow = v.Sync.Controllers.Default.Shadow
+	}
+	if reflect.DeepEqual(retConfig.Selector, config.SyncControllerSelector{}) {
+		retConfig.Selector = v.Sync.Controllers.Default.Selector
+	}
+
+	return retConfig
+}
+
+// SyncControllerLabelSelector converts the label requirements of the given selector into a label selector
+func SyncControllerLabelSelector(selector config.SyncControllerSelector) (labels.Selector, error) {
+	labelSelector := &metav1.LabelSelector{
+		MatchLabels: selector.Labels,
+	}
+	for _, expression := range selector.MatchExpressions {
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      expression.Key,
+			Operator: metav1.LabelSelectorOperator(expression.Operator),
+			Values:   expression.Values,
+		})
+	}
+
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
 // LegacyOptions converts the config to the legacy cluster options
 func (v VirtualClusterConfig) LegacyOptions() (*legacyconfig.LegacyVirtualClusterOptions, error) {
 	legacyPlugins := []string{}
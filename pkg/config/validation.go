@@ -5,10 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/loft-sh/vcluster/config"
+	patchesexpression "github.com/loft-sh/vcluster/pkg/patches/expression"
+	"github.com/loft-sh/vcluster/pkg/util/hostnaming"
 	"github.com/loft-sh/vcluster/pkg/util/toleration"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/validation"
@@ -69,8 +74,74 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 		return fmt.Errorf("you cannot enable both sync.fromHost.storageClasses.enabled and sync.toHost.storageClasses.enabled at the same time. Choose only one of them")
 	}
 
+	// check if priority classes and host priority classes are enabled at the same time
+	if config.Sync.FromHost.PriorityClasses.Enabled && config.Sync.ToHost.PriorityClasses.Enabled {
+		return fmt.Errorf("you cannot enable both sync.fromHost.priorityClasses.enabled and sync.toHost.priorityClasses.enabled at the same time. Choose only one of them")
+	}
+
+	// validate host priority class mappings
+	err := validatePriorityClassMappings(config.Sync.FromHost.PriorityClasses)
+	if err != nil {
+		return err
+	}
+
+	// check if storage class mappings and storage classes are enabled at the same time
+	if config.Sync.ToHost.StorageClasses.Enabled && (len(config.Sync.ToHost.PersistentVolumeClaims.StorageClasses.Mappings) > 0 || config.Sync.ToHost.PersistentVolumeClaims.StorageClasses.RejectUnmapped) {
+		return fmt.Errorf("you cannot use sync.toHost.persistentVolumeClaims.storageClasses and enable sync.toHost.storageClasses.enabled at the same time. Choose only one of them")
+	}
+
+	// validate storage class & ingress class mappings
+	err = validateClassMappings("sync.toHost.persistentVolumeClaims.storageClasses", config.Sync.ToHost.PersistentVolumeClaims.StorageClasses)
+	if err != nil {
+		return err
+	}
+	err = validateClassMappings("sync.toHost.ingresses.ingressClasses", config.Sync.ToHost.Ingresses.IngressClasses)
+	if err != nil {
+		return err
+	}
+
+	// validate imported host config maps & secrets
+	err = validateFromHostObjectMappings("sync.fromHost.configMaps", config.Sync.FromHost.ConfigMaps)
+	if err != nil {
+		return err
+	}
+	err = validateFromHostObjectMappings("sync.fromHost.secrets", config.Sync.FromHost.Secrets)
+	if err != nil {
+		return err
+	}
+
+	// validate mirrored custom resource definitions
+	err = validateCustomResourceDefinitionMirror(config)
+	if err != nil {
+		return err
+	}
+
+	// validate custom syncs
+	err = validateCustomSync(config)
+	if err != nil {
+		return err
+	}
+
+	// validate host naming templates
+	err = validateHostNaming(config.Sync.HostNaming)
+	if err != nil {
+		return err
+	}
+
+	// validate syncer controller settings
+	err = validateSyncControllers(config.Sync.Controllers)
+	if err != nil {
+		return err
+	}
+
+	// validate garbage collection
+	err = validateGarbageCollection(config.Sync.GarbageCollection)
+	if err != nil {
+		return err
+	}
+
 	// validate central admission control
-	err := validateCentralAdmissionControl(config)
+	err = validateCentralAdmissionControl(config)
 	if err != nil {
 		return err
 	}
@@ -114,6 +185,266 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 	return nil
 }
 
+func validateHostNaming(naming config.SyncHostNaming) error {
+	if naming.Namespaced != "" {
+		err := validateHostNameTemplate("sync.hostNaming.namespaced", naming.Namespaced, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if naming.ClusterScoped != "" {
+		err := validateHostNameTemplate("sync.hostNaming.clusterScoped", naming.ClusterScoped, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateHostNameTemplate makes sure the template renders valid names that differ between objects
+func validateHostNameTemplate(path, text string, namespaced bool) error {
+	err := hostnaming.Validate(path, text, namespaced)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func validatePriorityClassMappings(priorityClasses config.SyncFromHostPriorityClasses) error {
+	if priorityClasses.MaxValue < 0 {
+		return fmt.Errorf("sync.fromHost.priorityClasses.maxValue cannot be negative")
+	}
+
+	hostNames := map[string]string{}
+	for virtualName, hostName := range priorityClasses.Mappings {
+		if strings.HasPrefix(virtualName, "system-") {
+			return fmt.Errorf("sync.fromHost.priorityClasses.mappings.%s: priority class names with the system- prefix are reserved", virtualName)
+		} else if hostName == "" {
+			return fmt.Errorf("sync.fromHost.priorityClasses.mappings.%s: host priority class name is empty", virtualName)
+		} else if otherName, ok := hostNames[hostName]; ok {
+			return fmt.Errorf("sync.fromHost.priorityClasses.mappings: %s and %s are both mapped to host priority class %s", otherName, virtualName, hostName)
+		}
+
+		hostNames[hostName] = virtualName
+	}
+
+	return nil
+}
+
+func validateClassMappings(path string, classMappings config.ClassMappings) error {
+	for virtualName, hostName := range classMappings.Mappings {
+		if virtualName == "" {
+			return fmt.Errorf("%s.mappings: virtual class name is empty", path)
+		} else if hostName == "" {
+			return fmt.Errorf("%s.mappings.%s: host class name is empty", path, virtualName)
+		}
+	}
+
+	return nil
+}
+
+func validateFromHostObjectMappings(path string, objects config.SyncFromHostObjects) error {
+	if !objects.Enabled {
+		return nil
+	}
+
+	for i, mapping := range objects.Mappings {
+		from := strings.Split(mapping.From, "/")
+		to := strings.Split(mapping.To, "/")
+		if len(from) == 1 && from[0] != "" {
+			if len(to) != 1 || to[0] == "" {
+				return fmt.Errorf("%s.mappings[%d].to: needs to be a namespace if from is a namespace", path, i)
+			}
+		} else if len(from) == 2 && from[0] != "" && from[1] != "" {
+			if len(mapping.Selector) > 0 {
+				return fmt.Errorf("%s.mappings[%d].selector: can only be used if from is a namespace", path, i)
+			} else if len(to) != 2 || to[0] == "" || to[1] == "" {
+				return fmt.Errorf("%s.mappings[%d].to: needs to be in the form namespace/name", path, i)
+			}
+		} else {
+			return fmt.Errorf("%s.mappings[%d].from: needs to be in the form namespace/name or namespace", path, i)
+		}
+	}
+
+	return nil
+}
+
+func validateCustomResourceDefinitionMirror(vConfig *VirtualClusterConfig) error {
+	mirror := vConfig.Sync.FromHost.CustomResourceDefinitions
+	if !mirror.Enabled {
+		return nil
+	}
+
+	if len(mirror.Selector) == 0 {
+		return fmt.Errorf("sync.fromHost.customResourceDefinitions.selector: is required to select the mirrored custom resource definitions")
+	}
+
+	switch mirror.GenericSync {
+	case "", "export":
+	case "import":
+		if !vConfig.Experimental.MultiNamespaceMode.Enabled {
+			return fmt.Errorf("sync.fromHost.customResourceDefinitions.genericSync: import is allowed only in the multi-namespace mode")
+		}
+	default:
+		return fmt.Errorf("sync.fromHost.customResourceDefinitions.genericSync: unsupported value %q, must be either export or import", mirror.GenericSync)
+	}
+
+	return nil
+}
+
+func validateCustomSync(vConfig *VirtualClusterConfig) error {
+	gvks := map[string]bool{}
+	for _, e := range vConfig.Experimental.GenericSync.Exports {
+		if e != nil {
+			gvks[e.APIVersion+"|"+e.Kind] = true
+		}
+	}
+	for _, i := range vConfig.Experimental.GenericSync.Imports {
+		if i != nil {
+			gvks[i.APIVersion+"|"+i.Kind] = true
+		}
+	}
+
+	for idx, custom := range vConfig.Sync.Custom {
+		path := fmt.Sprintf("sync.custom[%d]", idx)
+		if custom.APIVersion == "" {
+			return fmt.Errorf("%s.apiVersion: is required", path)
+		} else if custom.Kind == "" {
+			return fmt.Errorf("%s.kind: is required", path)
+		} else if gvks[custom.APIVersion+"|"+custom.Kind] {
+			return fmt.Errorf("%s: %s(%s) is synced more than once, only one sync for each apiVersion and kind is permitted", path, custom.Kind, custom.APIVersion)
+		}
+		gvks[custom.APIVersion+"|"+custom.Kind] = true
+
+		switch custom.Direction {
+		case "", "toHost":
+			if len(custom.Ownership.Virtual) > 0 {
+				return fmt.Errorf("%s.ownership.virtual: is only supported for fromHost, as the virtual cluster owns all fields that are not owned by the host", path)
+			}
+		case "fromHost":
+			if !vConfig.Experimental.MultiNamespaceMode.Enabled {
+				return fmt.Errorf("%s.direction: fromHost is allowed only in the multi-namespace mode", path)
+			} else if len(custom.Selector) > 0 {
+				return fmt.Errorf("%s.selector: is only supported for toHost", path)
+			} else if len(custom.Ownership.Host) > 0 {
+				return fmt.Errorf("%s.ownership.host: is only supported for toHost, as the host cluster owns all fields that are not owned by the virtual cluster", path)
+			}
+		default:
+			return fmt.Errorf("%s.direction: unsupported value %q, must be either toHost or fromHost", path, custom.Direction)
+		}
+
+		for _, field := range append(append([]string{}, custom.Ownership.Host...), custom.Ownership.Virtual...) {
+			err := validateOwnedField(field)
+			if err != nil {
+				return fmt.Errorf("%s.ownership: invalid field %q: %w", path, field, err)
+			}
+		}
+
+		for patchIdx, patch := range custom.Patches {
+			err := validatePatch(patch)
+			if err != nil {
+				return fmt.Errorf("%s.patches[%d]: %w", path, patchIdx, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateOwnedField(field string) error {
+	segments := strings.Split(field, ".")
+	if slices.Contains(segments, "") {
+		return fmt.Errorf("must be a dot separated path such as spec.replicas")
+	}
+
+	switch segments[0] {
+	case "apiVersion", "kind", "metadata":
+		return fmt.Errorf("%s cannot be owned", segments[0])
+	}
+
+	return nil
+}
+
+func validateSyncControllers(controllers config.SyncControllers) error {
+	err := validateSyncController("sync.controllers.default", controllers.Default)
+	if err != nil {
+		return err
+	}
+
+	for name, controller := range controllers.Overrides {
+		err := validateSyncController("sync.controllers.overrides."+name, controller)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSyncController(path string, controller config.SyncController) error {
+	if controller.Workers < 0 {
+		return fmt.Errorf("%s.workers cannot be negative", path)
+	} else if controller.RateLimiter.QPS < 0 {
+		return fmt.Errorf("%s.rateLimiter.qps cannot be negative", path)
+	} else if controller.RateLimiter.Burst < 0 {
+		return fmt.Errorf("%s.rateLimiter.burst cannot be negative", path)
+	}
+
+	_, err := SyncControllerLabelSelector(controller.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid %s.selector: %w", path, err)
+	}
+	for _, pattern := range append(append([]string{}, controller.Selector.Namespaces...), controller.Selector.ExcludedNamespaces...) {
+		_, err := filepath.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid namespace pattern %q in %s.selector: %w", pattern, path, err)
+		}
+	}
+
+	switch controller.ConflictPolicy {
+	case "", config.ConflictPolicyRevert, config.ConflictPolicyAdoptIntoVirtual, config.ConflictPolicyIgnore, config.ConflictPolicyWarn:
+	default:
+		return fmt.Errorf("invalid %s.conflictPolicy %q, must be one of: %s, %s, %s, %s", path, controller.ConflictPolicy, config.ConflictPolicyRevert, config.ConflictPolicyAdoptIntoVirtual, config.ConflictPolicyIgnore, config.ConflictPolicyWarn)
+	}
+
+	if controller.RateLimiter.BaseDelay != "" {
+		_, err := time.ParseDuration(controller.RateLimiter.BaseDelay)
+		if err != nil {
+			return fmt.Errorf("invalid %s.rateLimiter.baseDelay: %w", path, err)
+		}
+	}
+	if controller.RateLimiter.MaxDelay != "" {
+		_, err := time.ParseDuration(controller.RateLimiter.MaxDelay)
+		if err != nil {
+			return fmt.Errorf("invalid %s.rateLimiter.maxDelay: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGarbageCollection(garbageCollection config.SyncGarbageCollection) error {
+	if garbageCollection.Interval != "" {
+		interval, err := time.ParseDuration(garbageCollection.Interval)
+		if err != nil {
+			return fmt.Errorf("invalid sync.garbageCollection.interval: %w", err)
+		} else if interval <= 0 {
+			return fmt.Errorf("sync.garbageCollection.interval needs to be greater than zero")
+		}
+	}
+	if garbageCollection.GracePeriod != "" {
+		_, err := time.ParseDuration(garbageCollection.GracePeriod)
+		if err != nil {
+			return fmt.Errorf("invalid sync.garbageCollection.gracePeriod: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func validateDistro(config *VirtualClusterConfig) error {
 	enabledDistros := 0
 	if config.Config.ControlPlane.Distro.K3S.Enabled {
@@ -235,6 +566,23 @@ func validateGenericSyncConfig(config config.ExperimentalGenericSync) error {
 }
 
 func validatePatch(patch *config.Patch) error {
+	if patch.Expression != "" && patch.Operation != config.PatchTypeExpression {
+		return fmt.Errorf("expression is only supported for the expression operation")
+	}
+
+	for idx, condition := range patch.Conditions {
+		if condition == nil || condition.Expression == "" {
+			continue
+		} else if condition.Path != "" || condition.SubPath != "" || condition.Equal != nil || condition.NotEqual != nil || condition.Empty != nil {
+			return fmt.Errorf("conditions[%d]: expression cannot be combined with other condition fields", idx)
+		}
+
+		_, err := patchesexpression.Compile(condition.Expression, true)
+		if err != nil {
+			return fmt.Errorf("conditions[%d]: invalid expression: %w", idx, err)
+		}
+	}
+
 	switch patch.Operation {
 	case config.PatchTypeRemove, config.PatchTypeReplace, config.PatchTypeAdd:
 		if patch.FromPath != "" {
@@ -249,6 +597,21 @@ func validatePatch(patch *config.Patch) error {
 			return fmt.Errorf("fromPath is required for this operation")
 		}
 
+		return nil
+	case config.PatchTypeExpression:
+		if patch.Path == "" {
+			return fmt.Errorf("path is required for this operation")
+		} else if patch.Expression == "" {
+			return fmt.Errorf("expression is required for this operation")
+		} else if patch.FromPath != "" || patch.Value != nil {
+			return fmt.Errorf("fromPath and value are not supported for this operation")
+		}
+
+		_, err := patchesexpression.Compile(patch.Expression, false)
+		if err != nil {
+			return fmt.Errorf("invalid expression: %w", err)
+		}
+
 		return nil
 	default:
 		return fmt.Errorf("unsupported patch type %s", patch.Operation)
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/loft-sh/vcluster/config"
@@ -102,6 +103,335 @@ HYMfRsCbvUOZ58SWLs5fyQ==
 	}
 }
 
+func TestValidateHostNaming(t *testing.T) {
+	testCases := []struct {
+		name    string
+		naming  config.SyncHostNaming
+		wantErr string
+	}{
+		{
+			name: "valid templates",
+			naming: config.SyncHostNaming{
+				Namespaced:    "{{.Namespace}}--{{.Name}}",
+				ClusterScoped: "{{.VClusterName}}--{{.Name}}",
+			},
+		},
+		{
+			name:    "missing namespace",
+			naming:  config.SyncHostNaming{Namespaced: "{{.VClusterName}}-{{.Name}}"},
+			wantErr: "invalid sync.hostNaming.namespaced: template needs to include {{.Namespace}}",
+		},
+		{
+			name:    "invalid characters",
+			naming:  config.SyncHostNaming{Namespaced: "{{.Namespace}}_{{.Name}}"},
+			wantErr: `invalid sync.hostNaming.namespaced: rendered name "namespace_name-5suqzui3c7" is invalid: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+		},
+		{
+			name:    "dots in namespaced name",
+			naming:  config.SyncHostNaming{Namespaced: "{{.Namespace}}.{{.Name}}"},
+			wantErr: `invalid sync.hostNaming.namespaced: rendered name "namespace.name-5suqzui3c7" is invalid: must not contain dots`,
+		},
+		{
+			name:   "dots in cluster scoped name",
+			naming: config.SyncHostNaming{ClusterScoped: "{{.VClusterName}}.{{.Name}}"},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHostNaming(tt.naming)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSyncController(t *testing.T) {
+	testCases := []struct {
+		name       string
+		controller config.SyncController
+		wantErr    string
+	}{
+		{
+			name: "valid settings",
+			controller: config.SyncController{
+				Workers: 50,
+				RateLimiter: config.SyncControllerRateLimiter{
+					BaseDelay: "10ms",
+					MaxDelay:  "5m",
+					QPS:       20,
+					Burst:     200,
+				},
+			},
+		},
+		{
+			name:       "negative workers",
+			controller: config.SyncController{Workers: -1},
+			wantErr:    "sync.controllers.overrides.pod.workers cannot be negative",
+		},
+		{
+			name:       "negative qps",
+			controller: config.SyncController{RateLimiter: config.SyncControllerRateLimiter{QPS: -1}},
+			wantErr:    "sync.controllers.overrides.pod.rateLimiter.qps cannot be negative",
+		},
+		{
+			name:       "negative burst",
+			controller: config.SyncController{RateLimiter: config.SyncControllerRateLimiter{Burst: -1}},
+			wantErr:    "sync.controllers.overrides.pod.rateLimiter.burst cannot be negative",
+		},
+		{
+			name:       "invalid base delay",
+			controller: config.SyncController{RateLimiter: config.SyncControllerRateLimiter{BaseDelay: "5"}},
+			wantErr:    `invalid sync.controllers.overrides.pod.rateLimiter.baseDelay: time: missing unit in duration "5"`,
+		},
+		{
+			name:       "invalid max delay",
+			controller: config.SyncController{RateLimiter: config.SyncControllerRateLimiter{MaxDelay: "forever"}},
+			wantErr:    `invalid sync.controllers.overrides.pod.rateLimiter.maxDelay: time: invalid duration "forever"`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSyncController("sync.controllers.overrides.pod", tt.controller)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+func TestValidatePriorityClassMappings(t *testing.T) {
+	testCases := []struct {
+		name            string
+		priorityClasses config.SyncFromHostPriorityClasses
+		wantErr         string
+	}{
+		{
+			name: "valid mappings",
+			priorityClasses: config.SyncFromHostPriorityClasses{
+				Mappings: map[string]string{"high": "tenant-high", "low": "tenant-low"},
+			},
+		},
+		{
+			name: "reserved virtual name",
+			priorityClasses: config.SyncFromHostPriorityClasses{
+				Mappings: map[string]string{"system-node-critical": "tenant-high"},
+			},
+			wantErr: "sync.fromHost.priorityClasses.mappings.system-node-critical: priority class names with the system- prefix are reserved",
+		},
+		{
+			name: "empty host name",
+			priorityClasses: config.SyncFromHostPriorityClasses{
+				Mappings: map[string]string{"high": ""},
+			},
+			wantErr: "sync.fromHost.priorityClasses.mappings.high: host priority class name is empty",
+		},
+		{
+			name: "negative max value",
+			priorityClasses: config.SyncFromHostPriorityClasses{
+				MaxValue: -1,
+			},
+			wantErr: "sync.fromHost.priorityClasses.maxValue cannot be negative",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePriorityClassMappings(tt.priorityClasses)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCustomResourceDefinitionMirror(t *testing.T) {
+	testCases := []struct {
+		name               string
+		mirror             config.SyncFromHostCustomResourceDefinitions
+		multiNamespaceMode bool
+		wantErr            string
+	}{
+		{
+			name:   "mirror only",
+			mirror: config.SyncFromHostCustomResourceDefinitions{Enabled: true, Selector: map[string]string{"vcluster.loft.sh/mirror": "true"}},
+		},
+		{
+			name:    "missing selector",
+			mirror:  config.SyncFromHostCustomResourceDefinitions{Enabled: true},
+			wantErr: "sync.fromHost.customResourceDefinitions.selector: is required to select the mirrored custom resource definitions",
+		},
+		{
+			name:    "import without multi-namespace mode",
+			mirror:  config.SyncFromHostCustomResourceDefinitions{Enabled: true, Selector: map[string]string{"mirror": "true"}, GenericSync: "import"},
+			wantErr: "sync.fromHost.customResourceDefinitions.genericSync: import is allowed only in the multi-namespace mode",
+		},
+		{
+			name:               "import with multi-namespace mode",
+			mirror:             config.SyncFromHostCustomResourceDefinitions{Enabled: true, Selector: map[string]string{"mirror": "true"}, GenericSync: "import"},
+			multiNamespaceMode: true,
+		},
+		{
+			name:    "unsupported generic sync",
+			mirror:  config.SyncFromHostCustomResourceDefinitions{Enabled: true, Selector: map[string]string{"mirror": "true"}, GenericSync: "both"},
+			wantErr: `sync.fromHost.customResourceDefinitions.genericSync: unsupported value "both", must be either export or import`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			vConfig := &VirtualClusterConfig{}
+			vConfig.Sync.FromHost.CustomResourceDefinitions = tt.mirror
+			vConfig.Experimental.MultiNamespaceMode.Enabled = tt.multiNamespaceMode
+			err := validateCustomResourceDefinitionMirror(vConfig)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCustomSync(t *testing.T) {
+	testCases := []struct {
+		name               string
+		custom             []config.SyncCustom
+		multiNamespaceMode bool
+		wantErr            string
+	}{
+		{
+			name: "to host with host owned fields",
+			custom: []config.SyncCustom{
+				{APIVersion: "example.com/v1", Kind: "Database", Ownership: config.SyncCustomOwnership{Host: []string{"spec.endpoint"}}},
+			},
+		},
+		{
+			name:    "missing kind",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1"}},
+			wantErr: "sync.custom[0].kind: is required",
+		},
+		{
+			name: "duplicate",
+			custom: []config.SyncCustom{
+				{APIVersion: "example.com/v1", Kind: "Database"},
+				{APIVersion: "example.com/v1", Kind: "Database", Direction: "toHost"},
+			},
+			wantErr: "sync.custom[1]: Database(example.com/v1) is synced more than once, only one sync for each apiVersion and kind is permitted",
+		},
+		{
+			name:    "virtual owned fields to host",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Ownership: config.SyncCustomOwnership{Virtual: []string{"spec"}}}},
+			wantErr: "sync.custom[0].ownership.virtual: is only supported for fromHost, as the virtual cluster owns all fields that are not owned by the host",
+		},
+		{
+			name:    "from host without multi-namespace mode",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Direction: "fromHost"}},
+			wantErr: "sync.custom[0].direction: fromHost is allowed only in the multi-namespace mode",
+		},
+		{
+			name:               "from host with virtual owned fields",
+			custom:             []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Direction: "fromHost", Ownership: config.SyncCustomOwnership{Virtual: []string{"spec.paused"}}}},
+			multiNamespaceMode: true,
+		},
+		{
+			name:               "from host with host owned fields",
+			custom:             []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Direction: "fromHost", Ownership: config.SyncCustomOwnership{Host: []string{"status"}}}},
+			multiNamespaceMode: true,
+			wantErr:            "sync.custom[0].ownership.host: is only supported for toHost, as the host cluster owns all fields that are not owned by the virtual cluster",
+		},
+		{
+			name:    "unsupported direction",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Direction: "both"}},
+			wantErr: `sync.custom[0].direction: unsupported value "both", must be either toHost or fromHost`,
+		},
+		{
+			name:    "owned metadata",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Ownership: config.SyncCustomOwnership{Host: []string{"metadata.labels"}}}},
+			wantErr: `sync.custom[0].ownership: invalid field "metadata.labels": metadata cannot be owned`,
+		},
+		{
+			name:    "invalid owned field",
+			custom:  []config.SyncCustom{{APIVersion: "example.com/v1", Kind: "Database", Ownership: config.SyncCustomOwnership{Host: []string{"spec..endpoint"}}}},
+			wantErr: `sync.custom[0].ownership: invalid field "spec..endpoint": must be a dot separated path such as spec.replicas`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			vConfig := &VirtualClusterConfig{}
+			vConfig.Sync.Custom = tt.custom
+			vConfig.Experimental.MultiNamespaceMode.Enabled = tt.multiNamespaceMode
+			err := validateCustomSync(vConfig)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePatchExpression(t *testing.T) {
+	testCases := []struct {
+		name    string
+		patch   *config.Patch
+		wantErr string
+	}{
+		{
+			name:  "valid expression",
+			patch: &config.Patch{Operation: config.PatchTypeExpression, Path: "spec.host", Expression: "object.metadata.name + '.example.com'"},
+		},
+		{
+			name:    "missing expression",
+			patch:   &config.Patch{Operation: config.PatchTypeExpression, Path: "spec.host"},
+			wantErr: "expression is required for this operation",
+		},
+		{
+			name:    "invalid expression",
+			patch:   &config.Patch{Operation: config.PatchTypeExpression, Path: "spec.host", Expression: "object.metadata.name +"},
+			wantErr: "invalid expression: ",
+		},
+		{
+			name:    "expression with other operation",
+			patch:   &config.Patch{Operation: config.PatchTypeReplace, Path: "spec.host", Expression: "'test'"},
+			wantErr: "expression is only supported for the expression operation",
+		},
+		{
+			name: "valid condition expression",
+			patch: &config.Patch{Operation: config.PatchTypeRemove, Path: "spec.debug", Conditions: []*config.PatchCondition{
+				{Expression: "sourceObject == null"},
+			}},
+		},
+		{
+			name: "condition expression returns no bool",
+			patch: &config.Patch{Operation: config.PatchTypeRemove, Path: "spec.debug", Conditions: []*config.PatchCondition{
+				{Expression: "'test'"},
+			}},
+			wantErr: "conditions[0]: invalid expression: expression needs to return a bool, but returns string",
+		},
+		{
+			name: "condition expression with path",
+			patch: &config.Patch{Operation: config.PatchTypeRemove, Path: "spec.debug", Conditions: []*config.PatchCondition{
+				{Path: "spec.debug", Expression: "true"},
+			}},
+			wantErr: "conditions[0]: expression cannot be combined with other condition fields",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePatch(tt.patch)
+			if err != nil && (tt.wantErr == "" || !strings.HasPrefix(err.Error(), tt.wantErr)) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
+
 func valHook(clientCfg config.ValidatingWebhookClientConfig) config.ValidatingWebhookConfiguration {
 	hook := config.ValidatingWebhookConfiguration{}
 	hook.APIVersion = "v1"
@@ -3,12 +3,19 @@ package constants
 import "time"
 
 const (
-	IndexByPhysicalName  = "IndexByPhysicalName"
-	IndexByVirtualName   = "IndexByVirtualName"
-	IndexByAssigned      = "IndexByAssigned"
-	IndexByIngressSecret = "IndexByIngressSecret"
-	IndexByPodSecret     = "IndexByPodSecret"
-	IndexByConfigMap     = "IndexByConfigMap"
+	IndexByPhysicalName                = "IndexByPhysicalName"
+	IndexByVirtualName                 = "IndexByVirtualName"
+	IndexByAssigned                    = "IndexByAssigned"
+	IndexByIngressSecret               = "IndexByIngressSecret"
+	IndexByGatewaySecret               = "IndexByGatewaySecret"
+	IndexByMonitorSecret               = "IndexByMonitorSecret"
+	IndexByIssuerSecret                = "IndexByIssuerSecret"
+	IndexByCertificateSecret           = "IndexByCertificateSecret"
+	IndexByIstioGatewaySecret          = "IndexByIstioGatewaySecret"
+	IndexBySecretStoreSecret           = "IndexBySecretStoreSecret"
+	IndexByTriggerAuthenticationSecret = "IndexByTriggerAuthenticationSecret"
+	IndexByPodSecret                   = "IndexByPodSecret"
+	IndexByConfigMap                   = "IndexByConfigMap"
 	// IndexByHostName is used to map rewritten hostnames(advertised as node addresses) to nodenames
 	IndexByHostName = "IndexByHostName"
 
@@ -0,0 +1,180 @@
+package crdmirror
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// MirroredLabel marks virtual custom resource definitions that are mirrored from the host cluster
+var MirroredLabel = "vcluster.loft.sh/mirrored"
+
+// CRDMirror mirrors host custom resource definitions that match the selector into the virtual cluster and optionally
+// starts a generic syncer for their objects
+type CRDMirror struct {
+	// Selector selects the mirrored host custom resource definitions
+	Selector labels.Selector
+
+	// GenericSync is either empty, export or import
+	GenericSync string
+
+	RegisterContext *synccontext.RegisterContext
+
+	Log loghelper.Logger
+
+	startedSyncersMutex sync.Mutex
+	startedSyncers      map[string]bool
+}
+
+func (m *CRDMirror) Register() error {
+	m.startedSyncers = map[string]bool{}
+	return ctrl.NewControllerManagedBy(m.RegisterContext.PhysicalManager).
+		WithOptions(controller.Options{
+			CacheSyncTimeout: constants.DefaultCacheSyncTimeout,
+		}).
+		Named("crd-mirror").
+		For(&apiextensionsv1.CustomResourceDefinition{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return m.Selector.Matches(labels.Set(object.GetLabels()))
+		}))).
+		WatchesRawSource(source.Kind(m.RegisterContext.VirtualManager.GetCache(), &apiextensionsv1.CustomResourceDefinition{}, handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, object *apiextensionsv1.CustomResourceDefinition) []reconcile.Request {
+			if object == nil || object.GetLabels()[MirroredLabel] != "true" {
+				return nil
+			}
+
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: object.GetName()}}}
+		}))).
+		Complete(m)
+}
+
+func (m *CRDMirror) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	pCRD := &apiextensionsv1.CustomResourceDefinition{}
+	err := m.RegisterContext.PhysicalManager.GetClient().Get(ctx, req.NamespacedName, pCRD)
+	if err != nil {
+		// we keep the virtual custom resource definition, as deleting it would delete all of its objects
+		if kerrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	} else if !m.Selector.Matches(labels.Set(pCRD.Labels)) {
+		return ctrl.Result{}, nil
+	}
+
+	newCRD := VirtualCustomResourceDefinition(pCRD)
+	vCRD := &apiextensionsv1.CustomResourceDefinition{}
+	err = m.RegisterContext.VirtualManager.GetClient().Get(ctx, req.NamespacedName, vCRD)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		m.Log.Infof("Mirror custom resource definition %s from host cluster", pCRD.Name)
+		err = m.RegisterContext.VirtualManager.GetClient().Create(ctx, newCRD)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if vCRD.Labels[MirroredLabel] != "true" {
+		// skip as the custom resource definition was created within the virtual cluster
+		m.Log.Debugf("Skip mirroring custom resource definition %s as it already exists in the virtual cluster", pCRD.Name)
+		return ctrl.Result{}, nil
+	} else if !apiequality.Semantic.DeepEqual(vCRD.Spec, newCRD.Spec) || !apiequality.Semantic.DeepEqual(vCRD.Labels, newCRD.Labels) {
+		m.Log.Infof("Update mirrored custom resource definition %s because host custom resource definition has changed", pCRD.Name)
+		vCRD.Labels = newCRD.Labels
+		vCRD.Spec = newCRD.Spec
+		err = m.RegisterContext.VirtualManager.GetClient().Update(ctx, vCRD)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	err = m.startGenericSync(newCRD)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("start generic sync for %s: %w", pCRD.Name, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// startGenericSync starts the generic syncer for the storage version of the given custom resource definition once. The
+// syncer is not restarted if the storage version changes later on.
+func (m *CRDMirror) startGenericSync(crd *apiextensionsv1.CustomResourceDefinition) error {
+	if m.GenericSync == "" {
+		return nil
+	}
+
+	m.startedSyncersMutex.Lock()
+	defer m.startedSyncersMutex.Unlock()
+	if m.startedSyncers[crd.Name] {
+		return nil
+	}
+
+	typeInformation := vclusterconfig.TypeInformation{Kind: crd.Spec.Names.Kind}
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			typeInformation.APIVersion = schema.GroupVersion{Group: crd.Spec.Group, Version: version.Name}.String()
+			break
+		}
+	}
+	if typeInformation.APIVersion == "" {
+		return fmt.Errorf("custom resource definition has no storage version")
+	}
+
+	var err error
+	syncBase := vclusterconfig.SyncBase{TypeInformation: typeInformation}
+	if m.GenericSync == "import" {
+		err = generic.RegisterImporter(m.RegisterContext, &vclusterconfig.Import{SyncBase: syncBase})
+	} else {
+		err = generic.RegisterExporter(m.RegisterContext, &vclusterconfig.Export{SyncBase: syncBase})
+	}
+	if err != nil {
+		return err
+	}
+
+	m.startedSyncers[crd.Name] = true
+	return nil
+}
+
+// VirtualCustomResourceDefinition returns the virtual custom resource definition for the given host one. Conversion
+// webhooks can't be reached from within the virtual cluster, so the versions are converted without a webhook.
+func VirtualCustomResourceDefinition(pCRD *apiextensionsv1.CustomResourceDefinition) *apiextensionsv1.CustomResourceDefinition {
+	vLabels := map[string]string{}
+	for key, value := range pCRD.Labels {
+		vLabels[key] = value
+	}
+	vLabels[MirroredLabel] = "true"
+
+	vCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   pCRD.Name,
+			Labels: vLabels,
+		},
+		Spec: *pCRD.Spec.DeepCopy(),
+	}
+	vCRD.Spec.PreserveUnknownFields = false
+	vCRD.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.NoneConverter,
+	}
+
+	return vCRD
+}
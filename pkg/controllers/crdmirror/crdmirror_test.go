@@ -0,0 +1,104 @@
+package crdmirror
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"gotest.tools/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func newCRD(name string, crdLabels map[string]string, versions ...string) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: crdLabels,
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "platform.example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Database", Plural: "databases"},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+			},
+		},
+	}
+	for i, version := range versions {
+		crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{
+			Name:    version,
+			Served:  true,
+			Storage: i == len(versions)-1,
+		})
+	}
+
+	return crd
+}
+
+func TestReconcile(t *testing.T) {
+	mirrorLabels := map[string]string{"vcluster.loft.sh/mirror": "true"}
+	testCases := []struct {
+		name        string
+		hostCRD     *apiextensionsv1.CustomResourceDefinition
+		virtualCRD  *apiextensionsv1.CustomResourceDefinition
+		expectedCRD *apiextensionsv1.CustomResourceDefinition
+	}{
+		{
+			name:        "mirror labeled crd",
+			hostCRD:     newCRD("databases.platform.example.com", mirrorLabels, "v1"),
+			expectedCRD: VirtualCustomResourceDefinition(newCRD("databases.platform.example.com", mirrorLabels, "v1")),
+		},
+		{
+			name:    "ignore unlabeled crd",
+			hostCRD: newCRD("databases.platform.example.com", nil, "v1"),
+		},
+		{
+			name:        "update mirrored crd with new version",
+			hostCRD:     newCRD("databases.platform.example.com", mirrorLabels, "v1", "v2"),
+			virtualCRD:  VirtualCustomResourceDefinition(newCRD("databases.platform.example.com", mirrorLabels, "v1")),
+			expectedCRD: VirtualCustomResourceDefinition(newCRD("databases.platform.example.com", mirrorLabels, "v1", "v2")),
+		},
+		{
+			name:        "keep crd created within the virtual cluster",
+			hostCRD:     newCRD("databases.platform.example.com", mirrorLabels, "v1", "v2"),
+			virtualCRD:  newCRD("databases.platform.example.com", nil, "v1"),
+			expectedCRD: newCRD("databases.platform.example.com", nil, "v1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pClient := testingutil.NewFakeClient(scheme.Scheme, testCase.hostCRD)
+			vClient := testingutil.NewFakeClient(scheme.Scheme)
+			if testCase.virtualCRD != nil {
+				vClient = testingutil.NewFakeClient(scheme.Scheme, testCase.virtualCRD)
+			}
+
+			mirror := &CRDMirror{
+				Selector:        labels.SelectorFromSet(mirrorLabels),
+				RegisterContext: syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient),
+				Log:             loghelper.New("crd-mirror"),
+			}
+			_, err := mirror.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: testCase.hostCRD.Name}})
+			assert.NilError(t, err)
+
+			vCRDs := &apiextensionsv1.CustomResourceDefinitionList{}
+			assert.NilError(t, vClient.List(context.TODO(), vCRDs))
+			if testCase.expectedCRD == nil {
+				assert.Equal(t, len(vCRDs.Items), 0)
+				return
+			}
+
+			assert.Equal(t, len(vCRDs.Items), 1)
+			assert.DeepEqual(t, vCRDs.Items[0].Labels, testCase.expectedCRD.Labels)
+			assert.DeepEqual(t, vCRDs.Items[0].Spec, testCase.expectedCRD.Spec)
+		})
+	}
+}
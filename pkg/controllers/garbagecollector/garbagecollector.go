@@ -0,0 +1,228 @@
+package garbagecollector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Register starts the garbage collector that periodically removes host objects whose
+// virtual object does not exist anymore
+func Register(ctx *synccontext.ControllerContext) error {
+	if !ctx.Config.Sync.GarbageCollection.Enabled {
+		return nil
+	}
+
+	interval, err := parseDuration(ctx.Config.Sync.GarbageCollection.Interval, 10*time.Minute)
+	if err != nil {
+		return fmt.Errorf("parse garbage collection interval: %w", err)
+	}
+	gracePeriod, err := parseDuration(ctx.Config.Sync.GarbageCollection.GracePeriod, time.Hour)
+	if err != nil {
+		return fmt.Errorf("parse garbage collection grace period: %w", err)
+	}
+
+	garbageCollector := &GarbageCollector{
+		SyncContext: ctx.ToRegisterContext().ToSyncContext("garbage-collector"),
+
+		HostReader:    ctx.LocalManager.GetAPIReader(),
+		HostClient:    ctx.LocalManager.GetClient(),
+		HostMapper:    ctx.LocalManager.GetRESTMapper(),
+		VirtualReader: ctx.VirtualManager.GetAPIReader(),
+
+		HostNamespace: HostNamespace(ctx.Config),
+		DryRun:        ctx.Config.Sync.GarbageCollection.DryRun,
+		GracePeriod:   gracePeriod,
+	}
+	go func() {
+		wait.UntilWithContext(ctx, func(_ context.Context) {
+			garbageCollector.Collect()
+		}, interval)
+	}()
+
+	return nil
+}
+
+// HostNamespace returns the host namespace the given vCluster syncs namespaced objects to, empty means all namespaces
+func HostNamespace(vConfig *config.VirtualClusterConfig) string {
+	// in multi namespace mode objects are spread across several host namespaces
+	if vConfig.Experimental.MultiNamespaceMode.Enabled {
+		return ""
+	}
+
+	return vConfig.WorkloadTargetNamespace
+}
+
+// GarbageCollector finds host objects that were synced by vCluster, but whose virtual object does not exist anymore
+type GarbageCollector struct {
+	SyncContext *synccontext.SyncContext
+
+	HostReader client.Reader
+	HostClient client.Client
+	HostMapper meta.RESTMapper
+
+	// VirtualReader is used to check if the virtual object still exists, nil means the virtual cluster does not
+	// exist anymore and all managed host objects are orphaned
+	VirtualReader client.Reader
+
+	// HostNamespace is the namespace to search for namespaced objects, empty means all namespaces
+	HostNamespace string
+
+	// DryRun only reports orphaned objects instead of deleting them
+	DryRun bool
+
+	// GracePeriod is the minimum age of a host object before it is considered orphaned
+	GracePeriod time.Duration
+}
+
+// Collect checks the host objects of all registered mappers once and returns the orphaned objects that were found
+func (g *GarbageCollector) Collect() []*metav1.PartialObjectMetadata {
+	orphans := []*metav1.PartialObjectMetadata{}
+	for _, mapper := range g.SyncContext.Mappings.List() {
+		orphans = append(orphans, g.collectKind(mapper.GroupVersionKind(), mapper)...)
+	}
+
+	return orphans
+}
+
+// CollectKinds checks the host objects of the given kinds once without using a mapper and returns the orphaned
+// objects that were found. This is used if the virtual cluster does not exist anymore.
+func (g *GarbageCollector) CollectKinds(gvks []schema.GroupVersionKind) []*metav1.PartialObjectMetadata {
+	orphans := []*metav1.PartialObjectMetadata{}
+	for _, gvk := range gvks {
+		orphans = append(orphans, g.collectKind(gvk, nil)...)
+	}
+
+	return orphans
+}
+
+func (g *GarbageCollector) collectKind(gvk schema.GroupVersionKind, mapper synccontext.Mapper) []*metav1.PartialObjectMetadata {
+	found, err := g.collectOrphans(gvk, mapper)
+	if err != nil {
+		g.SyncContext.Log.Infof("error garbage collecting %s: %v", gvk.String(), err)
+	}
+
+	return found
+}
+
+func (g *GarbageCollector) collectOrphans(gvk schema.GroupVersionKind, mapper synccontext.Mapper) ([]*metav1.PartialObjectMetadata, error) {
+	mapping, err := g.HostMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get rest mapping: %w", err)
+	}
+
+	// list the managed host objects
+	listOptions := []client.ListOption{client.MatchingLabels{translate.MarkerLabel: translate.VClusterName}}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if g.HostNamespace != "" {
+			listOptions = append(listOptions, client.InNamespace(g.HostNamespace))
+		}
+	} else {
+		listOptions = []client.ListOption{client.MatchingLabels{translate.MarkerLabel: translate.Default.MarkerLabelCluster()}}
+	}
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	err = g.HostReader.List(g.SyncContext, list, listOptions...)
+	if err != nil {
+		if kerrors.IsForbidden(err) || kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("list host objects: %w", err)
+	}
+
+	orphans := []*metav1.PartialObjectMetadata{}
+	for i := range list.Items {
+		pObj := &list.Items[i]
+		pObj.SetGroupVersionKind(gvk)
+		orphaned, err := g.isOrphaned(mapper, gvk, pObj)
+		if err != nil {
+			return orphans, err
+		} else if !orphaned {
+			continue
+		}
+
+		orphans = append(orphans, pObj)
+		if g.DryRun {
+			g.SyncContext.Log.Infof("found orphaned host %s %s/%s (dry run)", gvk.Kind, pObj.GetNamespace(), pObj.GetName())
+			continue
+		}
+
+		g.SyncContext.Log.Infof("delete orphaned host %s %s/%s", gvk.Kind, pObj.GetNamespace(), pObj.GetName())
+		err = g.HostClient.Delete(g.SyncContext, pObj, client.Preconditions{UID: &pObj.UID})
+		if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+			return orphans, fmt.Errorf("delete host object %s/%s: %w", pObj.GetNamespace(), pObj.GetName(), err)
+		}
+	}
+
+	return orphans, nil
+}
+
+func (g *GarbageCollector) isOrphaned(mapper synccontext.Mapper, gvk schema.GroupVersionKind, pObj *metav1.PartialObjectMetadata) (bool, error) {
+	// skip objects that are too young or already being deleted
+	if pObj.GetDeletionTimestamp() != nil || time.Since(pObj.GetCreationTimestamp().Time) < g.GracePeriod {
+		return false, nil
+	}
+
+	if mapper == nil {
+		if !translate.Default.IsManaged(g.SyncContext, pObj) {
+			return false, nil
+		}
+	} else {
+		managed, err := mapper.IsManaged(g.SyncContext, pObj)
+		if err != nil {
+			return false, fmt.Errorf("check if host object is managed: %w", err)
+		} else if !managed {
+			return false, nil
+		}
+	}
+
+	// without a virtual cluster every managed host object is orphaned
+	if g.VirtualReader == nil {
+		return true, nil
+	} else if mapper == nil {
+		return false, nil
+	}
+
+	// we can only be sure the object is orphaned if we know the virtual object
+	vName := mapper.HostToVirtual(g.SyncContext, types.NamespacedName{Namespace: pObj.GetNamespace(), Name: pObj.GetName()}, pObj)
+	if vName.Name == "" {
+		return false, nil
+	}
+
+	vObj := &metav1.PartialObjectMetadata{}
+	vObj.SetGroupVersionKind(gvk)
+	err := g.VirtualReader.Get(g.SyncContext, vName, vObj)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf("get virtual object %s: %w", vName.String(), err)
+	}
+
+	return false, nil
+}
+
+func parseDuration(value string, defaultDuration time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultDuration, nil
+	}
+
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,102 @@
+package garbagecollector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func hostSecret(name, namespace string, created time.Time) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              translate.Default.HostName(name, namespace),
+			Namespace:         syncertesting.DefaultTestTargetNamespace,
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				translate.MarkerLabel: translate.VClusterName,
+			},
+			Annotations: map[string]string{
+				translate.NameAnnotation:      name,
+				translate.NamespaceAnnotation: namespace,
+				translate.KindAnnotation:      corev1.SchemeGroupVersion.WithKind("Secret").String(),
+			},
+		},
+	}
+}
+
+func TestGarbageCollector(t *testing.T) {
+	vSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "exists",
+			Namespace: "default",
+		},
+	}
+	unmanagedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unmanaged",
+			Namespace: syncertesting.DefaultTestTargetNamespace,
+		},
+	}
+
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, vSecret)
+	registerContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+
+	oldTime := time.Now().Add(-2 * time.Hour)
+	existingSecret := hostSecret("exists", "default", oldTime)
+	orphanedSecret := hostSecret("deleted", "default", oldTime)
+	youngSecret := hostSecret("young", "default", time.Now())
+	for _, obj := range []*corev1.Secret{existingSecret, orphanedSecret, youngSecret, unmanagedSecret} {
+		assert.NilError(t, pClient.Create(context.TODO(), obj))
+	}
+
+	hostMapper := meta.NewDefaultRESTMapper(nil)
+	hostMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	garbageCollector := &GarbageCollector{
+		SyncContext: registerContext.ToSyncContext("garbage-collector"),
+
+		HostReader:    pClient,
+		HostClient:    pClient,
+		HostMapper:    hostMapper,
+		VirtualReader: vClient,
+
+		HostNamespace: syncertesting.DefaultTestTargetNamespace,
+		DryRun:        true,
+		GracePeriod:   time.Hour,
+	}
+
+	// dry run should only report the orphaned secret
+	orphans := garbageCollector.Collect()
+	assert.Equal(t, len(orphans), 1)
+	assert.Equal(t, orphans[0].GetName(), orphanedSecret.Name)
+	assert.NilError(t, pClient.Get(context.TODO(), types.NamespacedName{Namespace: orphanedSecret.Namespace, Name: orphanedSecret.Name}, &corev1.Secret{}))
+
+	// without dry run the orphaned secret should get deleted
+	garbageCollector.DryRun = false
+	orphans = garbageCollector.Collect()
+	assert.Equal(t, len(orphans), 1)
+	err := pClient.Get(context.TODO(), types.NamespacedName{Namespace: orphanedSecret.Namespace, Name: orphanedSecret.Name}, &corev1.Secret{})
+	assert.Assert(t, kerrors.IsNotFound(err))
+	for _, obj := range []*corev1.Secret{existingSecret, youngSecret, unmanagedSecret} {
+		assert.NilError(t, pClient.Get(context.TODO(), types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}, &corev1.Secret{}))
+	}
+
+	// without a virtual cluster every old enough managed host object is orphaned
+	garbageCollector.DryRun = true
+	garbageCollector.VirtualReader = nil
+	orphans = garbageCollector.CollectKinds([]schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")})
+	assert.Equal(t, len(orphans), 1)
+	assert.Equal(t, orphans[0].GetName(), existingSecret.Name)
+}
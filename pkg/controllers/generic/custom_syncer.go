@@ -0,0 +1,70 @@
+package generic
+
+import (
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+)
+
+const (
+	// CustomDirectionToHost syncs objects from the virtual cluster to the host
+	CustomDirectionToHost = "toHost"
+	// CustomDirectionFromHost syncs objects from the host into the virtual cluster
+	CustomDirectionFromHost = "fromHost"
+)
+
+// CreateCustomSyncers starts the syncers configured in sync.custom
+func CreateCustomSyncers(ctx *synccontext.ControllerContext) error {
+	if len(ctx.Config.Sync.Custom) == 0 {
+		return nil
+	}
+
+	registerCtx := ctx.ToRegisterContext()
+	for _, customConfig := range ctx.Config.Sync.Custom {
+		err := RegisterCustomSyncer(registerCtx, customConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterCustomSyncer copies the CRD of the given custom sync config from the host cluster and starts its syncer
+func RegisterCustomSyncer(registerCtx *synccontext.RegisterContext, customConfig vclusterconfig.SyncCustom) error {
+	syncBase := vclusterconfig.SyncBase{
+		TypeInformation: vclusterconfig.TypeInformation{
+			APIVersion: customConfig.APIVersion,
+			Kind:       customConfig.Kind,
+		},
+		Optional:           customConfig.Optional,
+		ReplaceWhenInvalid: customConfig.ReplaceWhenInvalid,
+		Patches:            customConfig.Patches,
+	}
+	if customConfig.Direction == CustomDirectionFromHost {
+		return registerImporter(registerCtx, &vclusterconfig.Import{SyncBase: syncBase}, VirtualOwnedFields(customConfig.Ownership))
+	}
+
+	exportConfig := &vclusterconfig.Export{SyncBase: syncBase}
+	if len(customConfig.Selector) > 0 {
+		exportConfig.Selector = &vclusterconfig.Selector{LabelSelector: customConfig.Selector}
+	}
+
+	return registerExporter(registerCtx, exportConfig, HostOwnedFields(customConfig.Ownership))
+}
+
+// HostOwnedFields returns the fields owned by the host cluster for a toHost custom sync, which always include the status
+func HostOwnedFields(ownership vclusterconfig.SyncCustomOwnership) []string {
+	fields := []string{"status"}
+	for _, field := range ownership.Host {
+		if field != "status" {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// VirtualOwnedFields returns the fields owned by the virtual cluster for a fromHost custom sync
+func VirtualOwnedFields(ownership vclusterconfig.SyncCustomOwnership) []string {
+	return append([]string{}, ownership.Virtual...)
+}
@@ -37,20 +37,38 @@ func CreateExporters(ctx *synccontext.ControllerContext) error {
 
 	registerCtx := ctx.ToRegisterContext()
 	for _, exportConfig := range exporterConfig.Exports {
-		_, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-			registerCtx,
-			registerCtx.PhysicalManager.GetConfig(),
-			registerCtx.VirtualManager.GetConfig(),
-			schema.FromAPIVersionAndKind(exportConfig.APIVersion, exportConfig.Kind))
+		err := RegisterExporter(registerCtx, exportConfig)
 		if err != nil {
-			if exportConfig.Optional {
-				klog.Infof("error ensuring CRD %s(%s) from host cluster: %v. Skipping exportSyncer as resource is optional", exportConfig.Kind, exportConfig.APIVersion, err)
-				continue
-			}
+			return err
+		}
+	}
+
+	return nil
+}
 
-			return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
+// RegisterExporter copies the CRD of the given export config from the host cluster and starts its export syncer
+func RegisterExporter(registerCtx *synccontext.RegisterContext, exportConfig *vclusterconfig.Export) error {
+	return registerExporter(registerCtx, exportConfig, nil)
+}
+
+// registerExporter registers the export syncer. If hostOwned is nil, the status and the reverse patches of the
+// export config are synced back into the virtual object, otherwise the given host owned fields.
+func registerExporter(registerCtx *synccontext.RegisterContext, exportConfig *vclusterconfig.Export, hostOwned []string) error {
+	_, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
+		registerCtx,
+		registerCtx.PhysicalManager.GetConfig(),
+		registerCtx.VirtualManager.GetConfig(),
+		schema.FromAPIVersionAndKind(exportConfig.APIVersion, exportConfig.Kind))
+	if err != nil {
+		if exportConfig.Optional {
+			klog.Infof("error ensuring CRD %s(%s) from host cluster: %v. Skipping exportSyncer as resource is optional", exportConfig.Kind, exportConfig.APIVersion, err)
+			return nil
 		}
 
+		return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
+	}
+
+	if hostOwned == nil {
 		reversePatches := []*vclusterconfig.Patch{
 			{
 				Operation: vclusterconfig.PatchTypeCopyFromObject,
@@ -60,24 +78,24 @@ func CreateExporters(ctx *synccontext.ControllerContext) error {
 		}
 		reversePatches = append(reversePatches, exportConfig.ReversePatches...)
 		exportConfig.ReversePatches = reversePatches
+	}
 
-		s, err := createExporterFromConfig(registerCtx, exportConfig, hasStatusSubresource)
-		klog.Infof("creating exporter for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
-		if err != nil {
-			return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
-		}
+	s, err := createExporterFromConfig(registerCtx, exportConfig, hasStatusSubresource, hostOwned)
+	klog.Infof("creating exporter for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
+	if err != nil {
+		return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
+	}
 
-		err = syncer.RegisterSyncer(registerCtx, s)
-		klog.Infof("registering export syncer for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
-		if err != nil {
-			return fmt.Errorf("error registering syncer %w", err)
-		}
+	err = syncer.RegisterSyncer(registerCtx, s)
+	klog.Infof("registering export syncer for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
+	if err != nil {
+		return fmt.Errorf("error registering syncer %w", err)
 	}
 
 	return nil
 }
 
-func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vclusterconfig.Export, hasStatusSubresource bool) (syncertypes.Syncer, error) {
+func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vclusterconfig.Export, hasStatusSubresource bool, hostOwned []string) (syncertypes.Syncer, error) {
 	obj := &unstructured.Unstructured{}
 	obj.SetKind(config.Kind)
 	obj.SetAPIVersion(config.APIVersion)
@@ -102,6 +120,11 @@ func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vcluster
 		return nil, err
 	}
 
+	patcher := NewPatcher(ctx.VirtualManager.GetClient(), ctx.PhysicalManager.GetClient(), hasStatusSubresource, log.New(controllerID))
+	if hostOwned != nil {
+		patcher = NewOwnershipPatcher(ctx.VirtualManager.GetClient(), ctx.PhysicalManager.GetClient(), hasStatusSubresource, hostOwned, log.New(controllerID))
+	}
+
 	return &exporter{
 		GenericTranslator: translator.NewGenericTranslator(ctx, controllerID, obj, mapper),
 		ObjectPatcher: &exportPatcher{
@@ -109,7 +132,7 @@ func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vcluster
 			gvk:    gvk,
 		},
 
-		patcher:  NewPatcher(ctx.VirtualManager.GetClient(), ctx.PhysicalManager.GetClient(), hasStatusSubresource, log.New(controllerID)),
+		patcher:  patcher,
 		gvk:      gvk,
 		selector: selector,
 		name:     controllerID,
@@ -158,7 +181,6 @@ func (f *exporter) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.S
 			return ctrl.Result{}, nil
 		}
 
-		f.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
 		return ctrl.Result{}, fmt.Errorf("error applying patches: %w", err)
 	} else if pObj == nil {
 		return ctrl.Result{}, nil
@@ -232,7 +254,6 @@ func (f *exporter) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEve
 			return ctrl.Result{Requeue: true}, nil
 		}
 
-		f.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing to virtual cluster: %v", err)
 		return ctrl.Result{}, fmt.Errorf("failed to patch virtual %s %s/%s: %w", f.gvk.Kind, event.Virtual.GetNamespace(), event.Virtual.GetName(), err)
 	} else if result == controllerutil.OperationResultUpdated || result == controllerutil.OperationResultUpdatedStatus || result == controllerutil.OperationResultUpdatedStatusOnly {
 		// a change will trigger reconciliation anyway, and at that point we can make
@@ -261,7 +282,6 @@ func (f *exporter) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEve
 			return ctrl.Result{Requeue: true}, nil
 		}
 
-		f.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
 		return ctrl.Result{}, fmt.Errorf("error applying patches: %w", err)
 	} else if pObj == nil {
 		return ctrl.Result{}, nil
@@ -17,6 +17,7 @@ import (
 
 	"github.com/loft-sh/vcluster/pkg/constants"
 	"github.com/loft-sh/vcluster/pkg/log"
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
 	"github.com/loft-sh/vcluster/pkg/util/clienthelper"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
@@ -45,50 +46,71 @@ func CreateImporters(ctx *synccontext.ControllerContext) error {
 	}
 
 	for _, importConfig := range cfg.Imports {
-		gvk := schema.FromAPIVersionAndKind(importConfig.APIVersion, importConfig.Kind)
-
-		// don't skip even if scheme.Recognizes(gvk) to ensure scope for builtin
-		// cluster scoped resources is registered and set properly
-		isClusterScoped, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-			registerCtx,
-			registerCtx.PhysicalManager.GetConfig(),
-			registerCtx.VirtualManager.GetConfig(),
-			gvk)
+		err := RegisterImporter(registerCtx, importConfig)
 		if err != nil {
-			if importConfig.Optional {
-				klog.Infof("error ensuring CRD %s(%s) from host cluster: %v, Skipping importSyncer as resource is optional", importConfig.Kind, importConfig.APIVersion, err)
-				continue
-			}
-
-			return fmt.Errorf("error syncronizing CRD %s(%s) from the host cluster into vcluster: %w", importConfig.Kind, importConfig.APIVersion, err)
+			return err
 		}
+	}
 
-		s, err := createImporter(registerCtx, importConfig, isClusterScoped, hasStatusSubresource)
-		klog.Infof("creating importer for %s/%s", importConfig.APIVersion, importConfig.Kind)
-		if err != nil {
-			return fmt.Errorf("error creating %s(%s) syncer: %w", importConfig.Kind, importConfig.APIVersion, err)
-		}
+	return nil
+}
 
-		err = syncer.RegisterSyncer(registerCtx, s)
-		klog.Infof("registering import syncer for %s/%s", importConfig.APIVersion, importConfig.Kind)
-		if err != nil {
-			return fmt.Errorf("error registering syncer %w", err)
+// RegisterImporter copies the CRD of the given import config from the host cluster and starts its import syncer
+func RegisterImporter(registerCtx *synccontext.RegisterContext, importConfig *vclusterconfig.Import) error {
+	return registerImporter(registerCtx, importConfig, nil)
+}
+
+// registerImporter registers the import syncer. If virtualOwned is nil, the reverse patches of the import config are
+// synced back into the host object, otherwise the given virtual owned fields.
+func registerImporter(registerCtx *synccontext.RegisterContext, importConfig *vclusterconfig.Import, virtualOwned []string) error {
+	gvk := schema.FromAPIVersionAndKind(importConfig.APIVersion, importConfig.Kind)
+
+	// don't skip even if scheme.Recognizes(gvk) to ensure scope for builtin
+	// cluster scoped resources is registered and set properly
+	isClusterScoped, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
+		registerCtx,
+		registerCtx.PhysicalManager.GetConfig(),
+		registerCtx.VirtualManager.GetConfig(),
+		gvk)
+	if err != nil {
+		if importConfig.Optional {
+			klog.Infof("error ensuring CRD %s(%s) from host cluster: %v, Skipping importSyncer as resource is optional", importConfig.Kind, importConfig.APIVersion, err)
+			return nil
 		}
+
+		return fmt.Errorf("error syncronizing CRD %s(%s) from the host cluster into vcluster: %w", importConfig.Kind, importConfig.APIVersion, err)
+	}
+
+	s, err := createImporter(registerCtx, importConfig, isClusterScoped, hasStatusSubresource, virtualOwned)
+	klog.Infof("creating importer for %s/%s", importConfig.APIVersion, importConfig.Kind)
+	if err != nil {
+		return fmt.Errorf("error creating %s(%s) syncer: %w", importConfig.Kind, importConfig.APIVersion, err)
+	}
+
+	err = syncer.RegisterSyncer(registerCtx, s)
+	klog.Infof("registering import syncer for %s/%s", importConfig.APIVersion, importConfig.Kind)
+	if err != nil {
+		return fmt.Errorf("error registering syncer %w", err)
 	}
 
 	return nil
 }
 
-func createImporter(ctx *synccontext.RegisterContext, config *vclusterconfig.Import, isClusterScoped, hasStatusSubresource bool) (syncertypes.Syncer, error) {
+func createImporter(ctx *synccontext.RegisterContext, config *vclusterconfig.Import, isClusterScoped, hasStatusSubresource bool, virtualOwned []string) (syncertypes.Syncer, error) {
 	gvk := schema.FromAPIVersionAndKind(config.APIVersion, config.Kind)
 	controllerID := fmt.Sprintf("%s/%s/GenericImport", strings.ToLower(gvk.Kind), strings.ToLower(gvk.GroupVersion().String()))
+	patcher := NewPatcher(ctx.PhysicalManager.GetClient(), ctx.VirtualManager.GetClient(), hasStatusSubresource, log.New(controllerID))
+	if virtualOwned != nil {
+		patcher = NewOwnershipPatcher(ctx.PhysicalManager.GetClient(), ctx.VirtualManager.GetClient(), hasStatusSubresource, virtualOwned, log.New(controllerID))
+	}
+
 	return &importer{
 		ObjectPatcher: &importPatcher{
 			config:        config,
 			virtualClient: ctx.VirtualManager.GetClient(),
 		},
 
-		patcher: NewPatcher(ctx.PhysicalManager.GetClient(), ctx.VirtualManager.GetClient(), hasStatusSubresource, log.New(controllerID)),
+		patcher: patcher,
 		gvk:     gvk,
 
 		replaceWhenInvalid: config.ReplaceWhenInvalid,
@@ -367,6 +389,10 @@ func (s *importer) IsManaged(_ *synccontext.SyncContext, pObj client.Object) (bo
 }
 
 func (s *importer) VirtualToHost(ctx *synccontext.SyncContext, req types.NamespacedName, vObj client.Object) types.NamespacedName {
+	if pName, ok := generic.RecordedHostName(ctx.Mappings, s.gvk, req); ok {
+		return pName
+	}
+
 	if s.virtualToHost != nil {
 		return s.virtualToHost(ctx, req, vObj)
 	}
@@ -375,6 +401,10 @@ func (s *importer) VirtualToHost(ctx *synccontext.SyncContext, req types.Namespa
 }
 
 func (s *importer) HostToVirtual(ctx *synccontext.SyncContext, req types.NamespacedName, pObj client.Object) types.NamespacedName {
+	if vName, ok := generic.RecordedVirtualName(ctx.Mappings, s.gvk, req); ok {
+		return vName
+	}
+
 	if s.syncerOptions.IsClusterScopedCRD {
 		return types.NamespacedName{
 			Name: req.Name,
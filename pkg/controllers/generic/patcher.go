@@ -2,6 +2,7 @@ package generic
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/loft-sh/vcluster/pkg/log"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
@@ -16,6 +17,9 @@ import (
 
 var fieldManager = "vcluster-syncer"
 
+// reverseFieldManager is the field manager used to apply the fields owned by the target cluster back to the source object
+var reverseFieldManager = "vcluster-syncer-reverse"
+
 type ObjectPatcherAndMetadataTranslator interface {
 	TranslateMetadata(ctx *synccontext.SyncContext, pObj client.Object) client.Object
 	ObjectPatcher
@@ -77,11 +81,28 @@ func NewPatcher(fromClient, toClient client.Client, statusIsSubresource bool, lo
 	}
 }
 
+// NewOwnershipPatcher creates a patcher that never applies the given fields owned by the target cluster to the target
+// object. Instead, they are applied back to the source object through server side apply with a separate field manager,
+// which means fields of the source object written by anyone else are never overwritten.
+func NewOwnershipPatcher(fromClient, toClient client.Client, statusIsSubresource bool, targetOwned []string, log log.Logger) *Patcher {
+	patcher := NewPatcher(fromClient, toClient, statusIsSubresource, log)
+	patcher.targetOwned = [][]string{}
+	for _, path := range targetOwned {
+		patcher.targetOwned = append(patcher.targetOwned, strings.Split(path, "."))
+	}
+
+	return patcher
+}
+
 type Patcher struct {
 	fromClient          client.Client
 	toClient            client.Client
 	log                 log.Logger
 	statusIsSubresource bool
+
+	// targetOwned are the field paths owned by the target cluster. If nil, the reverse patches of the modifier are used
+	// instead.
+	targetOwned [][]string
 }
 
 func (s *Patcher) ApplyPatches(ctx *synccontext.SyncContext, fromObj, toObj client.Object, modifier ObjectPatcherAndMetadataTranslator) (client.Object, error) {
@@ -98,6 +119,11 @@ func (s *Patcher) ApplyPatches(ctx *synccontext.SyncContext, fromObj, toObj clie
 		return nil, fmt.Errorf("error applying patches: %w", err)
 	}
 
+	// never apply fields owned by the target cluster
+	for _, path := range s.targetOwned {
+		unstructured.RemoveNestedField(toObjCopied.Object, path...)
+	}
+
 	// compare status
 	if s.statusIsSubresource && toObj != nil && toObj.GetUID() != "" {
 		_, hasAfterStatus, err := unstructured.NestedFieldCopy(toObjCopied.Object, "status")
@@ -132,6 +158,10 @@ func (s *Patcher) ApplyPatches(ctx *synccontext.SyncContext, fromObj, toObj clie
 }
 
 func (s *Patcher) ApplyReversePatches(ctx *synccontext.SyncContext, fromObj, otherObj client.Object, modifier ObjectPatcherAndMetadataTranslator) (controllerutil.OperationResult, error) {
+	if s.targetOwned != nil {
+		return s.applyOwnedFields(ctx, fromObj, otherObj)
+	}
+
 	originalUnstructured, err := toUnstructured(fromObj)
 	if err != nil {
 		return controllerutil.OperationResultNone, err
@@ -192,6 +222,91 @@ func (s *Patcher) ApplyReversePatches(ctx *synccontext.SyncContext, fromObj, oth
 	return controllerutil.OperationResultNone, nil
 }
 
+// applyOwnedFields server side applies the fields owned by the target cluster from otherObj to fromObj. Fields that were
+// removed from otherObj are removed from fromObj as well, as long as they are owned by the reverse field manager.
+func (s *Patcher) applyOwnedFields(ctx *synccontext.SyncContext, fromObj, otherObj client.Object) (controllerutil.OperationResult, error) {
+	fromUnstructured, err := toUnstructured(fromObj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	otherUnstructured, err := toUnstructured(otherObj)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	applyObj, changed := newApplyObject(fromUnstructured), false
+	applyStatusObj, statusChanged := newApplyObject(fromUnstructured), false
+	for _, path := range s.targetOwned {
+		value, found, err := unstructured.NestedFieldCopy(otherUnstructured.Object, path...)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		existingValue, existingFound, err := unstructured.NestedFieldCopy(fromUnstructured.Object, path...)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+
+		isStatus := s.statusIsSubresource && path[0] == "status"
+		if found != existingFound || !equality.Semantic.DeepEqual(value, existingValue) {
+			if isStatus {
+				statusChanged = true
+			} else {
+				changed = true
+			}
+		}
+		if !found {
+			continue
+		}
+
+		// always apply all owned fields, as omitted fields are removed by server side apply
+		target := applyObj
+		if isStatus {
+			target = applyStatusObj
+		}
+		err = unstructured.SetNestedField(target.Object, value, path...)
+		if err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+	}
+
+	if changed {
+		s.log.Infof("Server side apply owned fields of %s", fromUnstructured.GetName())
+		err = s.fromClient.Patch(ctx, applyObj, client.Apply, client.ForceOwnership, client.FieldOwner(reverseFieldManager))
+		if err != nil {
+			return controllerutil.OperationResultNone, errors.Wrap(err, "apply owned fields")
+		}
+	}
+	if statusChanged {
+		s.log.Infof("Server side apply owned status of %s", fromUnstructured.GetName())
+		o := &client.SubResourcePatchOptions{PatchOptions: client.PatchOptions{FieldManager: reverseFieldManager, Force: ptr.To(true)}}
+		err = s.fromClient.Status().Patch(ctx, applyStatusObj, client.Apply, o)
+		if err != nil {
+			return controllerutil.OperationResultNone, errors.Wrap(err, "apply owned status")
+		}
+	}
+
+	switch {
+	case changed && statusChanged:
+		return controllerutil.OperationResultUpdatedStatus, nil
+	case changed:
+		return controllerutil.OperationResultUpdated, nil
+	case statusChanged:
+		return controllerutil.OperationResultUpdatedStatusOnly, nil
+	}
+
+	return controllerutil.OperationResultNone, nil
+}
+
+// newApplyObject returns an empty apply configuration for the given object
+func newApplyObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	applyObj := &unstructured.Unstructured{}
+	applyObj.SetAPIVersion(obj.GetAPIVersion())
+	applyObj.SetKind(obj.GetKind())
+	applyObj.SetNamespace(obj.GetNamespace())
+	applyObj.SetName(obj.GetName())
+	return applyObj
+}
+
 func toUnstructured(obj client.Object) (*unstructured.Unstructured, error) {
 	if obj == nil {
 		return nil, errors.New("nil obj")
@@ -0,0 +1,147 @@
+package generic
+
+import (
+	"context"
+	"testing"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/log"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+type appliedPatch struct {
+	Object       map[string]interface{}
+	FieldManager string
+	Status       bool
+}
+
+// recordingClient records the server side applies instead of sending them to an api server
+type recordingClient struct {
+	client.Client
+
+	patches []appliedPatch
+}
+
+func (r *recordingClient) Patch(_ context.Context, obj client.Object, _ client.Patch, opts ...client.PatchOption) error {
+	patchOptions := &client.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+	r.patches = append(r.patches, appliedPatch{Object: obj.(*unstructured.Unstructured).Object, FieldManager: patchOptions.FieldManager})
+	return nil
+}
+
+func (r *recordingClient) Status() client.SubResourceWriter {
+	return &recordingStatusWriter{client: r}
+}
+
+type recordingStatusWriter struct {
+	client.SubResourceWriter
+
+	client *recordingClient
+}
+
+func (r *recordingStatusWriter) Patch(_ context.Context, obj client.Object, _ client.Patch, opts ...client.SubResourcePatchOption) error {
+	patchOptions := &client.SubResourcePatchOptions{}
+	patchOptions.ApplyOptions(opts)
+	r.client.patches = append(r.client.patches, appliedPatch{Object: obj.(*unstructured.Unstructured).Object, FieldManager: patchOptions.FieldManager, Status: true})
+	return nil
+}
+
+func newDatabase(spec, status map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata": map[string]interface{}{
+			"name":      "db",
+			"namespace": "test",
+		},
+		"spec": spec,
+	}}
+	if status != nil {
+		obj.Object["status"] = status
+	}
+
+	return obj
+}
+
+func newDatabaseApply(fields map[string]interface{}) map[string]interface{} {
+	obj := map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata": map[string]interface{}{
+			"name":      "db",
+			"namespace": "test",
+		},
+	}
+	for key, value := range fields {
+		obj[key] = value
+	}
+
+	return obj
+}
+
+func TestApplyOwnedFields(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		virtual *unstructured.Unstructured
+		host    *unstructured.Unstructured
+
+		expectedResult  controllerutil.OperationResult
+		expectedPatches []appliedPatch
+	}{
+		{
+			name:           "owned fields changed",
+			virtual:        newDatabase(map[string]interface{}{"size": "1Gi"}, nil),
+			host:           newDatabase(map[string]interface{}{"size": "1Gi", "endpoint": "db.example.com", "tier": "gold"}, map[string]interface{}{"ready": true}),
+			expectedResult: controllerutil.OperationResultUpdatedStatus,
+			expectedPatches: []appliedPatch{
+				{
+					Object:       newDatabaseApply(map[string]interface{}{"spec": map[string]interface{}{"endpoint": "db.example.com"}}),
+					FieldManager: reverseFieldManager,
+				},
+				{
+					Object:       newDatabaseApply(map[string]interface{}{"status": map[string]interface{}{"ready": true}}),
+					FieldManager: reverseFieldManager,
+					Status:       true,
+				},
+			},
+		},
+		{
+			name:           "owned fields unchanged",
+			virtual:        newDatabase(map[string]interface{}{"size": "2Gi", "endpoint": "db.example.com"}, map[string]interface{}{"ready": true}),
+			host:           newDatabase(map[string]interface{}{"size": "1Gi", "endpoint": "db.example.com"}, map[string]interface{}{"ready": true}),
+			expectedResult: controllerutil.OperationResultNone,
+		},
+		{
+			name:           "owned field removed",
+			virtual:        newDatabase(map[string]interface{}{"size": "1Gi", "endpoint": "db.example.com"}, map[string]interface{}{"ready": true}),
+			host:           newDatabase(map[string]interface{}{"size": "1Gi"}, map[string]interface{}{"ready": true}),
+			expectedResult: controllerutil.OperationResultUpdated,
+			expectedPatches: []appliedPatch{
+				{
+					Object:       newDatabaseApply(nil),
+					FieldManager: reverseFieldManager,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			virtualClient := &recordingClient{}
+			patcher := NewOwnershipPatcher(virtualClient, &recordingClient{}, true, []string{"status", "spec.endpoint"}, log.New("test"))
+			result, err := patcher.ApplyReversePatches(&synccontext.SyncContext{Context: context.Background()}, testCase.virtual, testCase.host, nil)
+			assert.NilError(t, err)
+			assert.Equal(t, result, testCase.expectedResult)
+			assert.DeepEqual(t, virtualClient.patches, testCase.expectedPatches)
+		})
+	}
+}
+
+func TestHostOwnedFields(t *testing.T) {
+	assert.DeepEqual(t, HostOwnedFields(vclusterconfig.SyncCustomOwnership{Host: []string{"spec.endpoint", "status"}}), []string{"status", "spec.endpoint"})
+}
@@ -0,0 +1,155 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TranslateFunc translates a copy of a top level field of obj in place
+type TranslateFunc func(ctx *synccontext.SyncContext, obj *unstructured.Unstructured, field map[string]interface{}) error
+
+// AfterSyncFunc is called after the host object was updated
+type AfterSyncFunc func(ctx *synccontext.SyncContext, vObj, pObj *unstructured.Unstructured) error
+
+// SpecSyncerOptions configure how a spec syncer translates the objects of an integration
+type SpecSyncerOptions struct {
+	// TranslateSpec translates the virtual spec before it is written to the host object
+	TranslateSpec TranslateFunc
+
+	// SyncStatus copies the host status back into the virtual object
+	SyncStatus bool
+
+	// TranslateStatus translates the host status before it is written to the virtual object
+	TranslateStatus TranslateFunc
+
+	// AfterSync is called after the host object was updated
+	AfterSync AfterSyncFunc
+}
+
+// NewSpecSyncer creates a syncer for a namespaced custom resource of an integration. The spec is owned by the
+// virtual object and, if enabled, the status by the host object.
+func NewSpecSyncer(ctx *synccontext.RegisterContext, name string, gvk schema.GroupVersionKind, options SpecSyncerOptions) (*SpecSyncer, error) {
+	mapper, err := ctx.Mappings.ByGVK(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return &SpecSyncer{
+		GenericTranslator: translator.NewGenericTranslator(ctx, name, obj, mapper),
+
+		options: options,
+	}, nil
+}
+
+// SpecSyncer syncs the spec of a namespaced unstructured object to the host cluster and optionally its
+// status back into the virtual cluster
+type SpecSyncer struct {
+	syncertypes.GenericTranslator
+
+	options SpecSyncerOptions
+}
+
+var _ syncertypes.Syncer = &SpecSyncer{}
+
+func (s *SpecSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*unstructured.Unstructured](s)
+}
+
+func (s *SpecSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*unstructured.Unstructured]) (ctrl.Result, error) {
+	if event.IsDelete() {
+		return syncer.DeleteVirtualObject(ctx, event.Virtual, "host object was deleted")
+	}
+
+	pObj := translate.HostMetadata(ctx, event.Virtual, s.VirtualToHost(ctx, types.NamespacedName{Name: event.Virtual.GetName(), Namespace: event.Virtual.GetNamespace()}, event.Virtual))
+	delete(pObj.Object, "status")
+	spec, ok, err := translateField(ctx, event.Virtual, "spec", s.options.TranslateSpec)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if ok {
+		pObj.Object["spec"] = spec
+	}
+
+	return syncer.CreateHostObject(ctx, event.Virtual, pObj)
+}
+
+func (s *SpecSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*unstructured.Unstructured]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	// spec is owned by the virtual object
+	spec, ok, err := translateField(ctx, event.Virtual, "spec", s.options.TranslateSpec)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if ok {
+		event.Host.Object["spec"] = spec
+	} else {
+		delete(event.Host.Object, "spec")
+	}
+
+	// status is owned by the host object
+	if s.options.SyncStatus {
+		status, ok, err := translateField(ctx, event.Host, "status", s.options.TranslateStatus)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if ok {
+			event.Virtual.Object["status"] = status
+		}
+	}
+
+	event.Host.SetAnnotations(translate.HostAnnotations(event.Virtual, event.Host))
+	event.Host.SetLabels(translate.HostLabels(ctx, event.Virtual, event.Host))
+
+	if s.options.AfterSync != nil {
+		err = s.options.AfterSync(ctx, event.Virtual, event.Host)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (s *SpecSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*unstructured.Unstructured]) (_ ctrl.Result, retErr error) {
+	// virtual object is not here anymore, so we delete
+	return syncer.DeleteHostObject(ctx, event.Host, "virtual object was deleted")
+}
+
+// translateField returns a translated copy of the given top level field of obj
+func translateField(ctx *synccontext.SyncContext, obj *unstructured.Unstructured, field string, translateFn TranslateFunc) (interface{}, bool, error) {
+	value, ok := obj.Object[field]
+	if !ok {
+		return nil, false, nil
+	}
+
+	value = runtime.DeepCopyJSONValue(value)
+	if m, ok := value.(map[string]interface{}); ok && translateFn != nil {
+		err := translateFn(ctx, obj, m)
+		if err != nil {
+			return nil, false, translator.NewTranslateError(err)
+		}
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,38 @@
+package generic
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NestedMaps returns the maps within the slice at the given path. The maps are not copied, so changes
+// to them are reflected in obj.
+func NestedMaps(obj map[string]interface{}, fields ...string) []map[string]interface{} {
+	value, ok, _ := unstructured.NestedFieldNoCopy(obj, fields...)
+	if !ok {
+		return nil
+	}
+
+	slice, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	retMaps := make([]map[string]interface{}, 0, len(slice))
+	for _, item := range slice {
+		if m, ok := item.(map[string]interface{}); ok {
+			retMaps = append(retMaps, m)
+		}
+	}
+
+	return retMaps
+}
+
+// StringsToInterfaces converts values into a slice that can be set within an unstructured object
+func StringsToInterfaces(values []string) []interface{} {
+	ret := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		ret = append(ret, value)
+	}
+
+	return ret
+}
@@ -0,0 +1,260 @@
+package importsync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// ImportedLabel marks virtual config maps and secrets that were imported from the host cluster
+	ImportedLabel = "vcluster.loft.sh/imported"
+	// ImportedFromAnnotation holds the host object in the form namespace/name a virtual object was imported from
+	ImportedFromAnnotation = "vcluster.loft.sh/imported-from"
+)
+
+// Mapping maps a single host object or all matching host objects of a namespace into the virtual cluster
+type Mapping struct {
+	// Host is the host object. If the name is empty, all objects of the namespace that match the selector are imported.
+	Host types.NamespacedName
+
+	// Selector selects the host objects if no host name is given
+	Selector labels.Selector
+
+	// Virtual is the virtual object. If the name is empty, the host name is used.
+	Virtual types.NamespacedName
+}
+
+// ParseMappings parses the given config mappings
+func ParseMappings(mappings []vclusterconfig.FromHostObjectMapping) ([]Mapping, error) {
+	ret := []Mapping{}
+	for _, m := range mappings {
+		from := strings.Split(m.From, "/")
+		to := strings.Split(m.To, "/")
+		if len(from) == 1 && len(to) == 1 {
+			ret = append(ret, Mapping{
+				Host:     types.NamespacedName{Namespace: from[0]},
+				Selector: labels.SelectorFromSet(m.Selector),
+				Virtual:  types.NamespacedName{Namespace: to[0]},
+			})
+		} else if len(from) == 2 && len(to) == 2 {
+			ret = append(ret, Mapping{
+				Host:    types.NamespacedName{Namespace: from[0], Name: from[1]},
+				Virtual: types.NamespacedName{Namespace: to[0], Name: to[1]},
+			})
+		} else {
+			return nil, fmt.Errorf("invalid mapping %s=%s, please use namespace1/name1=namespace2/name2 or namespace1=namespace2", m.From, m.To)
+		}
+	}
+
+	return ret, nil
+}
+
+// Namespaces returns the host namespaces of the given mappings
+func Namespaces(mappings []Mapping) []string {
+	ret := []string{}
+	for _, m := range mappings {
+		ret = append(ret, m.Host.Namespace)
+	}
+
+	return ret
+}
+
+// ImportSyncer imports config maps or secrets from host namespaces into the virtual cluster and keeps them in sync
+type ImportSyncer struct {
+	Name string
+
+	NewObject func() client.Object
+	NewList   func() client.ObjectList
+
+	Mappings []Mapping
+
+	From ctrl.Manager
+	To   ctrl.Manager
+
+	Log loghelper.Logger
+}
+
+func (e *ImportSyncer) Register() error {
+	return ctrl.NewControllerManagedBy(e.From).
+		WithOptions(controller.Options{
+			CacheSyncTimeout: constants.DefaultCacheSyncTimeout,
+		}).
+		Named(e.Name).
+		For(e.NewObject()).
+		WatchesRawSource(source.Kind(e.To.GetCache(), e.NewObject(), handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			if object == nil || object.GetLabels()[ImportedLabel] != "true" {
+				return nil
+			}
+
+			from := strings.Split(object.GetAnnotations()[ImportedFromAnnotation], "/")
+			if len(from) != 2 {
+				return nil
+			}
+
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: from[0], Name: from[1]}}}
+		}))).
+		Complete(e)
+}
+
+func (e *ImportSyncer) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	hostObj := e.NewObject()
+	err := e.From.GetClient().Get(ctx, req.NamespacedName, hostObj)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		hostObj = nil
+	}
+
+	// check which virtual objects should exist
+	targets := map[types.NamespacedName]bool{}
+	if hostObj != nil {
+		for _, target := range e.virtualTargets(hostObj) {
+			targets[target] = true
+		}
+	}
+
+	// delete imported objects that are not needed anymore
+	list := e.NewList()
+	err = e.To.GetClient().List(ctx, list, client.MatchingLabels{ImportedLabel: "true"})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, obj := range objs {
+		vObj := obj.(client.Object)
+		if vObj.GetAnnotations()[ImportedFromAnnotation] != req.String() || targets[types.NamespacedName{Namespace: vObj.GetNamespace(), Name: vObj.GetName()}] {
+			continue
+		}
+
+		e.Log.Infof("Delete imported object %s/%s because host object %s is missing or not imported anymore", vObj.GetNamespace(), vObj.GetName(), req.String())
+		err = e.To.GetClient().Delete(ctx, vObj)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// create or update the imported objects
+	for target := range targets {
+		err = e.syncVirtual(ctx, hostObj, target)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (e *ImportSyncer) virtualTargets(hostObj client.Object) []types.NamespacedName {
+	ret := []types.NamespacedName{}
+	for _, m := range e.Mappings {
+		if m.Host.Namespace != hostObj.GetNamespace() {
+			continue
+		}
+
+		if m.Host.Name == "" {
+			if m.Selector.Matches(labels.Set(hostObj.GetLabels())) {
+				ret = append(ret, types.NamespacedName{Namespace: m.Virtual.Namespace, Name: hostObj.GetName()})
+			}
+		} else if m.Host.Name == hostObj.GetName() {
+			ret = append(ret, m.Virtual)
+		}
+	}
+
+	return ret
+}
+
+func (e *ImportSyncer) syncVirtual(ctx context.Context, hostObj client.Object, target types.NamespacedName) error {
+	from := types.NamespacedName{Namespace: hostObj.GetNamespace(), Name: hostObj.GetName()}.String()
+	vObj := e.NewObject()
+	err := e.To.GetClient().Get(ctx, target, vObj)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		// make sure the namespace exists
+		err = e.To.GetClient().Get(ctx, types.NamespacedName{Name: target.Namespace}, &corev1.Namespace{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		} else if kerrors.IsNotFound(err) {
+			e.Log.Infof("Create namespace %s because it is missing", target.Namespace)
+			err = e.To.GetClient().Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: target.Namespace,
+				},
+			})
+			if err != nil && !kerrors.IsAlreadyExists(err) {
+				return err
+			}
+		}
+
+		vObj = e.NewObject()
+		vObj.SetName(target.Name)
+		vObj.SetNamespace(target.Namespace)
+		vObj.SetLabels(map[string]string{ImportedLabel: "true"})
+		vObj.SetAnnotations(map[string]string{ImportedFromAnnotation: from})
+		copyData(hostObj, vObj)
+		e.Log.Infof("Import host object %s into %s", from, target.String())
+		return e.To.GetClient().Create(ctx, vObj)
+	} else if vObj.GetAnnotations()[ImportedFromAnnotation] != from {
+		// skip as it seems the object was user created or imported from another host object
+		return nil
+	}
+
+	// secret types are immutable, so we need to recreate the secret. It will be imported
+	// again as soon as the deletion is observed.
+	if hostSecret, ok := hostObj.(*corev1.Secret); ok && hostSecret.Type != vObj.(*corev1.Secret).Type {
+		e.Log.Infof("Delete imported object %s because the secret type has changed", target.String())
+		err = e.To.GetClient().Delete(ctx, vObj)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	updated := vObj.DeepCopyObject().(client.Object)
+	copyData(hostObj, updated)
+	if apiequality.Semantic.DeepEqual(vObj, updated) {
+		return nil
+	}
+
+	e.Log.Infof("Update imported object %s because host object %s has changed", target.String(), from)
+	return e.To.GetClient().Update(ctx, updated)
+}
+
+func copyData(from, to client.Object) {
+	switch fromObj := from.(type) {
+	case *corev1.ConfigMap:
+		toObj := to.(*corev1.ConfigMap)
+		toObj.Data = fromObj.Data
+		toObj.BinaryData = fromObj.BinaryData
+	case *corev1.Secret:
+		toObj := to.(*corev1.Secret)
+		toObj.Type = fromObj.Type
+		toObj.Data = fromObj.Data
+	}
+}
@@ -0,0 +1,57 @@
+package importsync
+
+import (
+	"testing"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestVirtualTargets(t *testing.T) {
+	mappings, err := ParseMappings([]vclusterconfig.FromHostObjectMapping{
+		{From: "shared/ca-bundle", To: "default/ca-bundle"},
+		{From: "shared/ca-bundle", To: "kube-system/ca-bundle"},
+		{From: "registry", Selector: map[string]string{"import": "true"}, To: "registry"},
+	})
+	assert.NilError(t, err)
+
+	syncer := &ImportSyncer{Mappings: mappings}
+	testCases := []struct {
+		name     string
+		hostObj  *corev1.ConfigMap
+		expected []types.NamespacedName
+	}{
+		{
+			name:    "mapped by name",
+			hostObj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "ca-bundle"}},
+			expected: []types.NamespacedName{
+				{Namespace: "default", Name: "ca-bundle"},
+				{Namespace: "kube-system", Name: "ca-bundle"},
+			},
+		},
+		{
+			name:     "not mapped",
+			hostObj:  &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "other"}},
+			expected: []types.NamespacedName{},
+		},
+		{
+			name:     "mapped by selector",
+			hostObj:  &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "registry", Name: "pull-secret", Labels: map[string]string{"import": "true"}}},
+			expected: []types.NamespacedName{{Namespace: "registry", Name: "pull-secret"}},
+		},
+		{
+			name:     "selector does not match",
+			hostObj:  &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "registry", Name: "pull-secret"}},
+			expected: []types.NamespacedName{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.DeepEqual(t, syncer.virtualTargets(testCase.hostObj), testCase.expected)
+		})
+	}
+}
@@ -6,17 +6,24 @@ import (
 	"strings"
 
 	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/crdmirror"
 	"github.com/loft-sh/vcluster/pkg/controllers/deploy"
+	"github.com/loft-sh/vcluster/pkg/controllers/garbagecollector"
 	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/controllers/importsync"
 	"github.com/loft-sh/vcluster/pkg/controllers/servicesync"
 	"github.com/loft-sh/vcluster/pkg/syncer"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
 	"github.com/loft-sh/vcluster/pkg/util/blockingcacheclient"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/loft-sh/vcluster/pkg/controllers/coredns"
@@ -61,12 +68,30 @@ func RegisterControllers(ctx *synccontext.ControllerContext, syncers []syncertyp
 		return err
 	}
 
+	// register import syncers to import config maps & secrets from host namespaces
+	err = registerImportSyncControllers(ctx)
+	if err != nil {
+		return err
+	}
+
 	// register generic sync controllers
 	err = registerGenericSyncController(ctx)
 	if err != nil {
 		return err
 	}
 
+	// register controller that mirrors labeled custom resource definitions from the host
+	err = registerCRDMirrorController(ctx)
+	if err != nil {
+		return err
+	}
+
+	// register garbage collector that removes orphaned host objects
+	err = garbagecollector.Register(ctx)
+	if err != nil {
+		return err
+	}
+
 	// register controllers for resource synchronization
 	for _, v := range syncers {
 		// fake syncer?
@@ -111,6 +136,31 @@ func registerGenericSyncController(ctx *synccontext.ControllerContext) error {
 		return err
 	}
 
+	err = generic.CreateCustomSyncers(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func registerCRDMirrorController(ctx *synccontext.ControllerContext) error {
+	mirrorConfig := ctx.Config.Sync.FromHost.CustomResourceDefinitions
+	if !mirrorConfig.Enabled {
+		return nil
+	}
+
+	controller := &crdmirror.CRDMirror{
+		Selector:        labels.SelectorFromSet(mirrorConfig.Selector),
+		GenericSync:     mirrorConfig.GenericSync,
+		RegisterContext: ctx.ToRegisterContext(),
+		Log:             loghelper.New("crd-mirror"),
+	}
+	err := controller.Register()
+	if err != nil {
+		return errors.Wrap(err, "register crd mirror controller")
+	}
+
 	return nil
 }
 
@@ -128,32 +178,10 @@ func registerServiceSyncControllers(ctx *synccontext.ControllerContext) error {
 
 		// sync we are syncing from arbitrary physical namespaces we need to create a new
 		// manager that listens on global services
-		globalLocalManager, err := ctrl.NewManager(ctx.LocalManager.GetConfig(), ctrl.Options{
-			Scheme: ctx.LocalManager.GetScheme(),
-			MapperProvider: func(_ *rest.Config, _ *http.Client) (meta.RESTMapper, error) {
-				return ctx.LocalManager.GetRESTMapper(), nil
-			},
-			Metrics:        metricsserver.Options{BindAddress: "0"},
-			LeaderElection: false,
-			NewClient:      blockingcacheclient.NewCacheClient,
-		})
+		globalLocalManager, err := startGlobalLocalManager(ctx, nil)
 		if err != nil {
 			return err
 		}
-		if globalLocalManager == nil {
-			return errors.New("nil globalLocalManager")
-		}
-
-		// start the manager
-		go func() {
-			err := globalLocalManager.Start(ctx)
-			if err != nil {
-				panic(err)
-			}
-		}()
-
-		// Wait for caches to be synced
-		globalLocalManager.GetCache().WaitForCacheSync(ctx)
 
 		// register controller
 		controller := &servicesync.ServiceSyncer{
@@ -199,6 +227,100 @@ func registerServiceSyncControllers(ctx *synccontext.ControllerContext) error {
 	return nil
 }
 
+func registerImportSyncControllers(ctx *synccontext.ControllerContext) error {
+	for _, importer := range []struct {
+		name      string
+		config    vclusterconfig.SyncFromHostObjects
+		newObject func() client.Object
+		newList   func() client.ObjectList
+	}{
+		{
+			name:      "import-configmaps",
+			config:    ctx.Config.Sync.FromHost.ConfigMaps,
+			newObject: func() client.Object { return &corev1.ConfigMap{} },
+			newList:   func() client.ObjectList { return &corev1.ConfigMapList{} },
+		},
+		{
+			name:      "import-secrets",
+			config:    ctx.Config.Sync.FromHost.Secrets,
+			newObject: func() client.Object { return &corev1.Secret{} },
+			newList:   func() client.ObjectList { return &corev1.SecretList{} },
+		},
+	} {
+		if !importer.config.Enabled || len(importer.config.Mappings) == 0 {
+			continue
+		}
+
+		mappings, err := importsync.ParseMappings(importer.config.Mappings)
+		if err != nil {
+			return errors.Wrapf(err, "parse %s mappings", importer.name)
+		}
+
+		// we only watch the host namespaces objects are imported from
+		globalLocalManager, err := startGlobalLocalManager(ctx, importsync.Namespaces(mappings))
+		if err != nil {
+			return err
+		}
+
+		controller := &importsync.ImportSyncer{
+			Name:      importer.name,
+			NewObject: importer.newObject,
+			NewList:   importer.newList,
+			Mappings:  mappings,
+			From:      globalLocalManager,
+			To:        ctx.VirtualManager,
+			Log:       loghelper.New(importer.name),
+		}
+		err = controller.Register()
+		if err != nil {
+			return errors.Wrapf(err, "register %s controller", importer.name)
+		}
+	}
+
+	return nil
+}
+
+// startGlobalLocalManager starts a new host manager that is not restricted to the vCluster namespace. If namespaces
+// are given, the cache of the manager only holds objects from these namespaces.
+func startGlobalLocalManager(ctx *synccontext.ControllerContext, namespaces []string) (ctrl.Manager, error) {
+	cacheOptions := cache.Options{}
+	if len(namespaces) > 0 {
+		cacheOptions.DefaultNamespaces = map[string]cache.Config{}
+		for _, namespace := range namespaces {
+			cacheOptions.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+
+	globalLocalManager, err := ctrl.NewManager(ctx.LocalManager.GetConfig(), ctrl.Options{
+		Scheme: ctx.LocalManager.GetScheme(),
+		MapperProvider: func(_ *rest.Config, _ *http.Client) (meta.RESTMapper, error) {
+			return ctx.LocalManager.GetRESTMapper(), nil
+		},
+		Cache:          cacheOptions,
+		Metrics:        metricsserver.Options{BindAddress: "0"},
+		LeaderElection: false,
+		NewClient:      blockingcacheclient.NewCacheClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if globalLocalManager == nil {
+		return nil, errors.New("nil globalLocalManager")
+	}
+
+	// start the manager
+	go func() {
+		err := globalLocalManager.Start(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	// Wait for caches to be synced
+	globalLocalManager.GetCache().WaitForCacheSync(ctx)
+	return globalLocalManager, nil
+}
+
 func parseMapping(mappings []vclusterconfig.ServiceMapping, fromDefaultNamespace, toDefaultNamespace string) (map[string]types.NamespacedName, error) {
 	ret := map[string]types.NamespacedName{}
 	for _, m := range mappings {
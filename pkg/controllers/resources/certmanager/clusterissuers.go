@@ -0,0 +1,98 @@
+package certmanager
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClusterIssuers creates a syncer that mirrors the selected host cluster issuers into the virtual cluster
+func NewClusterIssuers(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.ClusterIssuers())
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterIssuerSyncer{
+		Mapper: mapper,
+
+		selector: labels.SelectorFromSet(ctx.Config.Integrations.CertManager.Sync.FromHost.ClusterIssuers.Selector),
+	}, nil
+}
+
+type clusterIssuerSyncer struct {
+	synccontext.Mapper
+
+	selector labels.Selector
+}
+
+func (c *clusterIssuerSyncer) Name() string {
+	return "clusterissuer"
+}
+
+func (c *clusterIssuerSyncer) Resource() client.Object {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mappings.ClusterIssuers())
+	return obj
+}
+
+var _ syncertypes.ObjectExcluder = &clusterIssuerSyncer{}
+
+func (c *clusterIssuerSyncer) ExcludeVirtual(_ client.Object) bool {
+	return false
+}
+
+func (c *clusterIssuerSyncer) ExcludePhysical(pObj client.Object) bool {
+	return !c.selector.Matches(labels.Set(pObj.GetLabels()))
+}
+
+var _ syncertypes.Syncer = &clusterIssuerSyncer{}
+
+func (c *clusterIssuerSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*unstructured.Unstructured](c)
+}
+
+func (c *clusterIssuerSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*unstructured.Unstructured]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, types.NamespacedName{Name: event.Host.GetName()}, false)
+	ctx.Log.Infof("create cluster issuer %s, because it does not exist in virtual cluster", vObj.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (c *clusterIssuerSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*unstructured.Unstructured]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.SetAnnotations(event.Host.GetAnnotations())
+	event.Virtual.SetLabels(event.Host.GetLabels())
+	for _, field := range []string{"spec", "status"} {
+		if value, ok := event.Host.Object[field]; ok {
+			event.Virtual.Object[field] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (c *clusterIssuerSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*unstructured.Unstructured]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual cluster issuer %s, because physical object is missing", event.Virtual.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
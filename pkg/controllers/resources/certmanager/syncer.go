@@ -0,0 +1,26 @@
+package certmanager
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewCertificates creates a syncer for cert-manager certificates. The tls secrets issued in the host cluster
+// are synced back into the virtual cluster.
+func NewCertificates(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "certificate", mappings.Certificates(), generic.SpecSyncerOptions{
+		TranslateSpec: translateCertificateSpec(ctx.Config.Integrations.CertManager.Sync.FromHost.ClusterIssuers),
+		SyncStatus:    true,
+		AfterSync:     syncCertificateSecret,
+	})
+}
+
+// NewIssuers creates a syncer for cert-manager issuers
+func NewIssuers(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "issuer", mappings.Issuers(), generic.SpecSyncerOptions{
+		TranslateSpec: translateIssuerSpec,
+		SyncStatus:    true,
+	})
+}
@@ -0,0 +1,199 @@
+package certmanager
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/hostsecrets"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableCertManager := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Integrations.CertManager.Enabled = true
+		vConfig.Integrations.CertManager.Sync.ToHost.Certificates.Enabled = true
+		vConfig.Integrations.CertManager.Sync.ToHost.Issuers.Enabled = true
+		vConfig.Integrations.CertManager.Sync.FromHost.ClusterIssuers.Enabled = true
+		vConfig.Integrations.CertManager.Sync.FromHost.ClusterIssuers.Selector = map[string]string{"tenants": "true"}
+	}
+
+	vCertificate := syncertesting.NewUnstructured(mappings.Certificates(), "certificate", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"dnsNames":   []interface{}{"example.com"},
+			"secretName": "tls",
+			"issuerRef":  map[string]interface{}{"name": "issuer"},
+		},
+	})
+	pCertificate := syncertesting.NewUnstructured(mappings.Certificates(), translate.Default.HostName("certificate", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("certificate", "test", mappings.Certificates()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"dnsNames":   []interface{}{"example.com"},
+			"secretName": translate.Default.HostName("tls", "test"),
+			"issuerRef":  map[string]interface{}{"name": translate.Default.HostName("issuer", "test")},
+		},
+	})
+
+	pCertificateReady := pCertificate.DeepCopy()
+	pCertificateReady.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True"},
+		},
+	}
+	vCertificateReady := vCertificate.DeepCopy()
+	vCertificateReady.Object["status"] = runtime.DeepCopyJSONValue(pCertificateReady.Object["status"])
+	pSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      translate.Default.HostName("tls", "test"),
+			Namespace: syncertesting.DefaultTestTargetNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{"tls.crt": []byte("crt"), "tls.key": []byte("key")},
+	}
+	vSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tls",
+			Namespace:   "test",
+			Labels:      map[string]string{translate.ControllerLabel: SecretController},
+			Annotations: map[string]string{hostsecrets.IssuedByAnnotation: "Certificate/certificate"},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: pSecret.Data,
+	}
+
+	vClusterIssuerCertificate := vCertificate.DeepCopy()
+	vClusterIssuerCertificate.Object["spec"].(map[string]interface{})["issuerRef"] = map[string]interface{}{"name": "letsencrypt", "kind": "ClusterIssuer"}
+	pClusterIssuerCertificate := pCertificate.DeepCopy()
+	pClusterIssuerCertificate.Object["spec"].(map[string]interface{})["issuerRef"] = map[string]interface{}{"name": "letsencrypt", "kind": "ClusterIssuer"}
+	pClusterIssuer := syncertesting.NewUnstructured(mappings.ClusterIssuers(), "letsencrypt", "", nil, map[string]string{"tenants": "true"}, map[string]interface{}{
+		"spec": map[string]interface{}{"acme": map[string]interface{}{"server": "https://acme.example.com"}},
+	})
+	pPrivateClusterIssuer := syncertesting.NewUnstructured(mappings.ClusterIssuers(), "letsencrypt", "", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{"acme": map[string]interface{}{"server": "https://acme.example.com"}},
+	})
+
+	vIssuer := syncertesting.NewUnstructured(mappings.Issuers(), "issuer", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"ca": map[string]interface{}{"secretName": "ca"},
+		},
+	})
+	pIssuer := syncertesting.NewUnstructured(mappings.Issuers(), translate.Default.HostName("issuer", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("issuer", "test", mappings.Issuers()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"ca": map[string]interface{}{"secretName": translate.Default.HostName("ca", "test")},
+		},
+	})
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create certificate",
+			AdjustConfig:        enableCertManager,
+			InitialVirtualState: []runtime.Object{vCertificate.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Certificates(): {pCertificate.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewCertificates)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vCertificate.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Create certificate with synced cluster issuer",
+			AdjustConfig:         enableCertManager,
+			InitialVirtualState:  []runtime.Object{vClusterIssuerCertificate.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pClusterIssuer.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Certificates(): {pClusterIssuerCertificate.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewCertificates)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClusterIssuerCertificate.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Reject certificate with not synced cluster issuer",
+			AdjustConfig:         enableCertManager,
+			InitialVirtualState:  []runtime.Object{vClusterIssuerCertificate.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pPrivateClusterIssuer.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Certificates(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewCertificates)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClusterIssuerCertificate.DeepCopy()))
+				assert.ErrorContains(t, err, "cluster issuer letsencrypt cannot be used")
+			},
+		},
+		{
+			Name:                 "Sync issued certificate secret back",
+			AdjustConfig:         enableCertManager,
+			InitialVirtualState:  []runtime.Object{vCertificate.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pCertificateReady.DeepCopy(), pSecret.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Certificates(): {vCertificateReady.DeepCopy()},
+				mappings.Secrets():      {vSecret.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewCertificates)
+				_, err := syncer.(*generic.SpecSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pCertificateReady.DeepCopy(), vCertificate.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create issuer",
+			AdjustConfig:        enableCertManager,
+			InitialVirtualState: []runtime.Object{vIssuer.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Issuers(): {pIssuer.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewIssuers)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vIssuer.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Mirror cluster issuer",
+			AdjustConfig:         enableCertManager,
+			InitialPhysicalState: []runtime.Object{pClusterIssuer.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ClusterIssuers(): {pClusterIssuer.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewClusterIssuers)
+				assert.Assert(t, syncer.(*clusterIssuerSyncer).ExcludePhysical(pPrivateClusterIssuer.DeepCopy()))
+				_, err := syncer.(*clusterIssuerSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pClusterIssuer.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromIssuer(t *testing.T) {
+	issuer := syncertesting.NewUnstructured(mappings.Issuers(), "issuer", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"acme": map[string]interface{}{
+				"privateKeySecretRef": map[string]interface{}{"name": "account"},
+				"solvers": []interface{}{
+					map[string]interface{}{
+						"dns01": map[string]interface{}{
+							"cloudflare": map[string]interface{}{
+								"apiTokenSecretRef": map[string]interface{}{"name": "cloudflare", "key": "token"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromIssuer, issuer, "test/account", "test/cloudflare")
+}
@@ -0,0 +1,183 @@
+package certmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/hostsecrets"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	certManagerGroup = "cert-manager.io"
+
+	// SecretController is the controller label value of virtual secrets that hold a certificate issued in the host cluster
+	SecretController = "cert-manager"
+)
+
+// translateCertificateSpec translates the secret names and the issuer reference of a certificate. Certificates
+// may only reference cluster issuers that are synced from the host cluster.
+func translateCertificateSpec(clusterIssuers config.CertManagerClusterIssuers) generic.TranslateFunc {
+	return func(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+		vNamespace := vObj.GetNamespace()
+		if secretName, ok := spec["secretName"].(string); ok && secretName != "" {
+			spec["secretName"] = mappings.VirtualToHostName(ctx, secretName, vNamespace, mappings.Secrets())
+		}
+		for _, keystore := range []string{"jks", "pkcs12"} {
+			if passwordSecretRef, ok, _ := unstructured.NestedFieldNoCopy(spec, "keystores", keystore, "passwordSecretRef"); ok {
+				translateSecretRefs(ctx, map[string]interface{}{"passwordSecretRef": passwordSecretRef}, vNamespace)
+			}
+		}
+
+		issuerRef, ok := spec["issuerRef"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		name, _ := issuerRef["name"].(string)
+		group, _ := issuerRef["group"].(string)
+		kind, _ := issuerRef["kind"].(string)
+		if name == "" || (group != "" && group != certManagerGroup) {
+			// external issuers are used as they are
+			return nil
+		}
+
+		switch kind {
+		case "", "Issuer":
+			if ctx.Mappings.Has(mappings.Issuers()) {
+				issuerRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Issuers())
+			} else {
+				issuerRef["name"] = translate.Default.HostName(name, vNamespace)
+			}
+		case "ClusterIssuer":
+			return validateClusterIssuer(ctx, clusterIssuers, name)
+		}
+
+		return nil
+	}
+}
+
+// validateClusterIssuer returns an error if the given host cluster issuer is not synced into the virtual cluster
+func validateClusterIssuer(ctx *synccontext.SyncContext, clusterIssuers config.CertManagerClusterIssuers, name string) error {
+	if !clusterIssuers.Enabled {
+		return fmt.Errorf("cluster issuer %s cannot be used, because cluster issuers are not synced from the host cluster", name)
+	}
+
+	pClusterIssuer := &unstructured.Unstructured{}
+	pClusterIssuer.SetGroupVersionKind(mappings.ClusterIssuers())
+	err := ctx.PhysicalClient.Get(ctx, types.NamespacedName{Name: name}, pClusterIssuer)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("cluster issuer %s does not exist in the host cluster", name)
+		}
+
+		return err
+	} else if !labels.SelectorFromSet(clusterIssuers.Selector).Matches(labels.Set(pClusterIssuer.GetLabels())) {
+		return fmt.Errorf("cluster issuer %s cannot be used, because it is not synced from the host cluster", name)
+	}
+
+	return nil
+}
+
+// translateIssuerSpec translates all secret references of an issuer
+func translateIssuerSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	translateSecretRefs(ctx, spec, vObj.GetNamespace())
+	return nil
+}
+
+// translateSecretRefs translates the names of all secrets referenced within obj. cert-manager references secrets
+// either through fields ending with SecretRef that hold a name or through secretName fields.
+func translateSecretRefs(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	for key, value := range obj {
+		switch v := value.(type) {
+		case string:
+			if key == "secretName" && v != "" {
+				obj[key] = mappings.VirtualToHostName(ctx, v, vNamespace, mappings.Secrets())
+			}
+		case map[string]interface{}:
+			if name, ok := v["name"].(string); ok && name != "" && isSecretRefField(key) {
+				v["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Secrets())
+			} else {
+				translateSecretRefs(ctx, v, vNamespace)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					translateSecretRefs(ctx, m, vNamespace)
+				}
+			}
+		}
+	}
+}
+
+// SecretNamesFromIssuer returns the namespace/name of the secrets referenced by an issuer
+func SecretNamesFromIssuer(issuer *unstructured.Unstructured) []string {
+	spec, _ := issuer.Object["spec"].(map[string]interface{})
+	return translate.UniqueSlice(secretNamesFromRefs(spec, issuer.GetNamespace()))
+}
+
+// SecretNamesFromCertificate returns the namespace/name of the keystore password secrets referenced by a certificate.
+// The secret the certificate is stored in is not included, as it is issued in the host cluster.
+func SecretNamesFromCertificate(certificate *unstructured.Unstructured) []string {
+	keystores, _, _ := unstructured.NestedMap(certificate.Object, "spec", "keystores")
+	return translate.UniqueSlice(secretNamesFromRefs(keystores, certificate.GetNamespace()))
+}
+
+func secretNamesFromRefs(obj map[string]interface{}, namespace string) []string {
+	secrets := []string{}
+	for key, value := range obj {
+		switch v := value.(type) {
+		case string:
+			if key == "secretName" && v != "" {
+				secrets = append(secrets, namespace+"/"+v)
+			}
+		case map[string]interface{}:
+			if name, ok := v["name"].(string); ok && name != "" && isSecretRefField(key) {
+				secrets = append(secrets, namespace+"/"+name)
+			} else {
+				secrets = append(secrets, secretNamesFromRefs(v, namespace)...)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					secrets = append(secrets, secretNamesFromRefs(m, namespace)...)
+				}
+			}
+		}
+	}
+
+	return secrets
+}
+
+func isSecretRefField(key string) bool {
+	return strings.HasSuffix(key, "SecretRef") || key == "secretRef"
+}
+
+// syncCertificateSecret copies the tls secret issued for the host certificate into the virtual namespace of the certificate
+func syncCertificateSecret(ctx *synccontext.SyncContext, vCertificate, pCertificate *unstructured.Unstructured) error {
+	vSecretName, _, _ := unstructured.NestedString(vCertificate.Object, "spec", "secretName")
+	pSecretName, _, _ := unstructured.NestedString(pCertificate.Object, "spec", "secretName")
+	if vSecretName == "" || pSecretName == "" {
+		return nil
+	}
+
+	err := hostsecrets.SyncToVirtual(
+		ctx,
+		types.NamespacedName{Namespace: pCertificate.GetNamespace(), Name: pSecretName},
+		types.NamespacedName{Namespace: vCertificate.GetNamespace(), Name: vSecretName},
+		SecretController,
+		vCertificate.GetKind()+"/"+vCertificate.GetName(),
+	)
+	if err != nil {
+		return fmt.Errorf("sync certificate secret: %w", err)
+	}
+
+	return nil
+}
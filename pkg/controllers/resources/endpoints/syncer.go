@@ -52,7 +52,7 @@ func (s *endpointsSyncer) SyncToHost(ctx *synccontext.SyncContext, event *syncco
 		return syncer.DeleteVirtualObject(ctx, event.Virtual, "host object was deleted")
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, s.translate(ctx, event.Virtual), s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, s.translate(ctx, event.Virtual))
 }
 
 func (s *endpointsSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*corev1.Endpoints]) (_ ctrl.Result, retErr error) {
@@ -64,15 +64,11 @@ func (s *endpointsSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = errors.Join(retErr, err)
 		}
-
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
 	err = s.translateUpdate(ctx, event.Host, event.Virtual)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator.NewTranslateError(err)
 	}
 
 	return ctrl.Result{}, nil
@@ -0,0 +1,99 @@
+package endpointslices
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManagedByVCluster is the endpoint slice managed by label value of endpoint slices synced by vCluster
+const ManagedByVCluster = "vcluster.loft.sh"
+
+// kubernetesControllers are the controllers managing endpoint slices that get recreated in the host
+// cluster by the same controllers, so we don't sync them
+var kubernetesControllers = map[string]bool{
+	"endpointslice-controller.k8s.io":          true,
+	"endpointslicemirroring-controller.k8s.io": true,
+}
+
+func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.EndpointSlices())
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointSliceSyncer{
+		GenericTranslator: translator.NewGenericTranslator(ctx, "endpointslice", &discoveryv1.EndpointSlice{}, mapper),
+	}, nil
+}
+
+type endpointSliceSyncer struct {
+	syncertypes.GenericTranslator
+}
+
+var _ syncertypes.Syncer = &endpointSliceSyncer{}
+
+func (s *endpointSliceSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*discoveryv1.EndpointSlice](s)
+}
+
+var _ syncertypes.ObjectExcluder = &endpointSliceSyncer{}
+
+func (s *endpointSliceSyncer) ExcludeVirtual(vObj client.Object) bool {
+	if kubernetesControllers[vObj.GetLabels()[discoveryv1.LabelManagedBy]] {
+		return true
+	}
+
+	return isControlledByOtherSyncer(vObj, s.Name())
+}
+
+func (s *endpointSliceSyncer) ExcludePhysical(pObj client.Object) bool {
+	return isControlledByOtherSyncer(pObj, s.Name())
+}
+
+func (s *endpointSliceSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*discoveryv1.EndpointSlice]) (ctrl.Result, error) {
+	if event.IsDelete() {
+		return syncer.DeleteVirtualObject(ctx, event.Virtual, "host object was deleted")
+	}
+
+	return syncer.CreateHostObject(ctx, event.Virtual, s.translate(ctx, event.Virtual))
+}
+
+func (s *endpointSliceSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*discoveryv1.EndpointSlice]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	s.translateUpdate(ctx, event)
+	return ctrl.Result{}, nil
+}
+
+func (s *endpointSliceSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*discoveryv1.EndpointSlice]) (_ ctrl.Result, retErr error) {
+	// virtual object is not here anymore, so we delete
+	return syncer.DeleteHostObject(ctx, event.Host, "virtual object was deleted")
+}
+
+func isControlledByOtherSyncer(obj client.Object, name string) bool {
+	if obj.GetLabels()[translate.ControllerLabel] != "" {
+		return true
+	}
+
+	controller := obj.GetAnnotations()[translate.ControllerLabel]
+	return controller != "" && controller != name
+}
@@ -0,0 +1,179 @@
+package endpointslices
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+func TestSync(t *testing.T) {
+	gvk := discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice")
+	enableEndpointSlices := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.ToHost.EndpointSlices.Enabled = true
+	}
+
+	vObjectMeta := metav1.ObjectMeta{
+		Name:      "test-slice",
+		Namespace: "test",
+		Labels: map[string]string{
+			discoveryv1.LabelServiceName: "test-service",
+		},
+	}
+	pObjectMeta := metav1.ObjectMeta{
+		Name:      translate.Default.HostName("test-slice", "test"),
+		Namespace: "test",
+		Annotations: map[string]string{
+			translate.NameAnnotation:      vObjectMeta.Name,
+			translate.NamespaceAnnotation: vObjectMeta.Namespace,
+			translate.UIDAnnotation:       "",
+			translate.KindAnnotation:      gvk.String(),
+		},
+		Labels: map[string]string{
+			translate.MarkerLabel:    translate.VClusterName,
+			translate.NamespaceLabel: vObjectMeta.Namespace,
+			translate.Default.HostLabel(nil, discoveryv1.LabelServiceName): "test-service",
+			discoveryv1.LabelServiceName:                                   translate.Default.HostName("test-service", "test"),
+			discoveryv1.LabelManagedBy:                                     ManagedByVCluster,
+		},
+	}
+	ports := []discoveryv1.EndpointPort{
+		{
+			Name: ptr.To("http"),
+			Port: ptr.To(int32(80)),
+		},
+	}
+
+	vEndpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta:  vObjectMeta,
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{
+					Kind:      "Pod",
+					Name:      "test-pod",
+					Namespace: "test",
+					UID:       "123",
+				},
+			},
+		},
+		Ports: ports,
+	}
+	pEndpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta:  pObjectMeta,
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{
+					Kind:      "Pod",
+					Name:      translate.Default.HostName("test-pod", "test"),
+					Namespace: "test",
+				},
+			},
+		},
+		Ports: ports,
+	}
+
+	vUpdatedEndpointSlice := vEndpointSlice.DeepCopy()
+	vUpdatedEndpointSlice.Endpoints[0].Addresses = []string{"10.0.0.2"}
+	pUpdatedEndpointSlice := pEndpointSlice.DeepCopy()
+	pUpdatedEndpointSlice.Endpoints[0].Addresses = []string{"10.0.0.2"}
+
+	vBackwardEndpointSlice := vUpdatedEndpointSlice.DeepCopy()
+	vBackwardEndpointSlice.Endpoints[0].TargetRef.UID = ""
+	vPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "test",
+		},
+	}
+
+	vControllerEndpointSlice := vEndpointSlice.DeepCopy()
+	vControllerEndpointSlice.Labels[discoveryv1.LabelManagedBy] = "endpointslice-controller.k8s.io"
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create forward",
+			AdjustConfig:        enableEndpointSlices,
+			InitialVirtualState: []runtime.Object{vEndpointSlice.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {vEndpointSlice.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {pEndpointSlice.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*endpointSliceSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vEndpointSlice.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update forward",
+			AdjustConfig:         enableEndpointSlices,
+			InitialVirtualState:  []runtime.Object{vUpdatedEndpointSlice.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pEndpointSlice.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {vUpdatedEndpointSlice.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {pUpdatedEndpointSlice.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*endpointSliceSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pEndpointSlice.DeepCopy(), vUpdatedEndpointSlice.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update backward",
+			AdjustConfig:         enableEndpointSlices,
+			InitialVirtualState:  []runtime.Object{vEndpointSlice.DeepCopy(), vPod.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pUpdatedEndpointSlice.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {vBackwardEndpointSlice.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {pUpdatedEndpointSlice.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*endpointSliceSyncer).Sync(syncCtx, synccontext.NewSyncEventWithSource(pUpdatedEndpointSlice.DeepCopy(), vEndpointSlice.DeepCopy(), synccontext.SyncEventSourceHost))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Delete host endpoint slice without virtual",
+			AdjustConfig:         enableEndpointSlices,
+			InitialPhysicalState: []runtime.Object{pEndpointSlice.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				gvk: {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*endpointSliceSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pEndpointSlice.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:         "Exclude endpoint slices managed by kubernetes",
+			AdjustConfig: enableEndpointSlices,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				_, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				assert.Assert(t, syncer.(*endpointSliceSyncer).ExcludeVirtual(vControllerEndpointSlice.DeepCopy()))
+				assert.Assert(t, !syncer.(*endpointSliceSyncer).ExcludeVirtual(vEndpointSlice.DeepCopy()))
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package endpointslices
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func (s *endpointSliceSyncer) translate(ctx *synccontext.SyncContext, vEndpointSlice *discoveryv1.EndpointSlice) *discoveryv1.EndpointSlice {
+	pEndpointSlice := translate.HostMetadata(ctx, vEndpointSlice, s.VirtualToHost(ctx, types.NamespacedName{Name: vEndpointSlice.Name, Namespace: vEndpointSlice.Namespace}, vEndpointSlice))
+	pEndpointSlice.Endpoints = translateEndpointsToHost(ctx, vEndpointSlice.Endpoints)
+	translateServiceLabelsToHost(ctx, vEndpointSlice, pEndpointSlice)
+	return pEndpointSlice
+}
+
+func (s *endpointSliceSyncer) translateUpdate(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*discoveryv1.EndpointSlice]) {
+	// update endpoints bidirectionally
+	if event.Source == synccontext.SyncEventSourceHost {
+		event.Virtual.Endpoints = translateEndpointsToVirtual(ctx, event.Host.Endpoints)
+		event.Virtual.Ports = event.Host.Ports
+	} else {
+		event.Host.Endpoints = translateEndpointsToHost(ctx, event.Virtual.Endpoints)
+		event.Host.Ports = event.Virtual.Ports
+	}
+
+	// check annotations & labels
+	event.Host.Annotations = translate.HostAnnotations(event.Virtual, event.Host)
+	event.Host.Labels = translate.HostLabels(ctx, event.Virtual, event.Host)
+	translateServiceLabelsToHost(ctx, event.Virtual, event.Host)
+}
+
+// translateServiceLabelsToHost points the host endpoint slice to the synced host service
+func translateServiceLabelsToHost(ctx *synccontext.SyncContext, vEndpointSlice, pEndpointSlice *discoveryv1.EndpointSlice) {
+	if pEndpointSlice.Labels == nil {
+		pEndpointSlice.Labels = map[string]string{}
+	}
+
+	pEndpointSlice.Labels[discoveryv1.LabelManagedBy] = ManagedByVCluster
+	if serviceName := vEndpointSlice.Labels[discoveryv1.LabelServiceName]; serviceName != "" {
+		pEndpointSlice.Labels[discoveryv1.LabelServiceName] = mappings.VirtualToHostName(ctx, serviceName, vEndpointSlice.Namespace, mappings.Services())
+	}
+}
+
+func translateEndpointsToHost(ctx *synccontext.SyncContext, vEndpoints []discoveryv1.Endpoint) []discoveryv1.Endpoint {
+	pEndpoints := make([]discoveryv1.Endpoint, 0, len(vEndpoints))
+	for _, vEndpoint := range vEndpoints {
+		pEndpoint := *vEndpoint.DeepCopy()
+		if pEndpoint.TargetRef != nil && pEndpoint.TargetRef.Kind == "Pod" {
+			pName := mappings.VirtualToHost(ctx, pEndpoint.TargetRef.Name, pEndpoint.TargetRef.Namespace, mappings.Pods())
+			pEndpoint.TargetRef.Name = pName.Name
+			pEndpoint.TargetRef.Namespace = pName.Namespace
+			pEndpoint.TargetRef.UID = ""
+			pEndpoint.TargetRef.ResourceVersion = ""
+		}
+
+		pEndpoints = append(pEndpoints, pEndpoint)
+	}
+
+	return pEndpoints
+}
+
+func translateEndpointsToVirtual(ctx *synccontext.SyncContext, pEndpoints []discoveryv1.Endpoint) []discoveryv1.Endpoint {
+	vEndpoints := make([]discoveryv1.Endpoint, 0, len(pEndpoints))
+	for _, pEndpoint := range pEndpoints {
+		vEndpoint := *pEndpoint.DeepCopy()
+		if vEndpoint.TargetRef != nil && vEndpoint.TargetRef.Kind == "Pod" {
+			vName := mappings.HostToVirtual(ctx, vEndpoint.TargetRef.Name, vEndpoint.TargetRef.Namespace, nil, mappings.Pods())
+			if vName.Name != "" {
+				vEndpoint.TargetRef.Name = vName.Name
+				vEndpoint.TargetRef.Namespace = vName.Namespace
+			}
+			vEndpoint.TargetRef.UID = ""
+			vEndpoint.TargetRef.ResourceVersion = ""
+		}
+
+		vEndpoints = append(vEndpoints, vEndpoint)
+	}
+
+	return vEndpoints
+}
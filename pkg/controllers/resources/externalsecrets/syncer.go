@@ -0,0 +1,26 @@
+package externalsecrets
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewExternalSecrets creates a syncer for external secrets. The secrets fetched in the host cluster are synced
+// back into the virtual cluster.
+func NewExternalSecrets(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "externalsecret", mappings.ExternalSecrets(), generic.SpecSyncerOptions{
+		TranslateSpec: translateExternalSecretSpec,
+		SyncStatus:    true,
+		AfterSync:     syncTargetSecret,
+	})
+}
+
+// NewSecretStores creates a syncer for secret stores
+func NewSecretStores(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "secretstore", mappings.SecretStores(), generic.SpecSyncerOptions{
+		TranslateSpec: translateSecretStoreSpec,
+		SyncStatus:    true,
+	})
+}
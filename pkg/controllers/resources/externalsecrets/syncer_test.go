@@ -0,0 +1,177 @@
+package externalsecrets
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/hostsecrets"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableExternalSecrets := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Integrations.ExternalSecrets.Enabled = true
+		vConfig.Integrations.ExternalSecrets.Sync.ExternalSecrets.Enabled = true
+		vConfig.Integrations.ExternalSecrets.Sync.Stores.Enabled = true
+	}
+
+	vExternalSecret := syncertesting.NewUnstructured(mappings.ExternalSecrets(), "database", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretStoreRef": map[string]interface{}{"name": "vault", "kind": "SecretStore"},
+			"data": []interface{}{
+				map[string]interface{}{"secretKey": "password", "remoteRef": map[string]interface{}{"key": "database/password"}},
+			},
+		},
+	})
+	pExternalSecret := syncertesting.NewUnstructured(mappings.ExternalSecrets(), translate.Default.HostName("database", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("database", "test", mappings.ExternalSecrets()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretStoreRef": map[string]interface{}{"name": translate.Default.HostName("vault", "test"), "kind": "SecretStore"},
+			"data": []interface{}{
+				map[string]interface{}{"secretKey": "password", "remoteRef": map[string]interface{}{"key": "database/password"}},
+			},
+			"target": map[string]interface{}{"name": translate.Default.HostName("database", "test")},
+		},
+	})
+
+	pExternalSecretReady := pExternalSecret.DeepCopy()
+	pExternalSecretReady.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True"},
+		},
+	}
+	vExternalSecretReady := vExternalSecret.DeepCopy()
+	vExternalSecretReady.Object["status"] = runtime.DeepCopyJSONValue(pExternalSecretReady.Object["status"])
+	pSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      translate.Default.HostName("database", "test"),
+			Namespace: syncertesting.DefaultTestTargetNamespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{"password": []byte("secret")},
+	}
+	vSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "database",
+			Namespace:   "test",
+			Labels:      map[string]string{translate.ControllerLabel: SecretController},
+			Annotations: map[string]string{hostsecrets.IssuedByAnnotation: "ExternalSecret/database"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: pSecret.Data,
+	}
+
+	vClusterStoreExternalSecret := vExternalSecret.DeepCopy()
+	vClusterStoreExternalSecret.Object["spec"].(map[string]interface{})["secretStoreRef"] = map[string]interface{}{"name": "vault", "kind": "ClusterSecretStore"}
+
+	vSecretStore := syncertesting.NewUnstructured(mappings.SecretStores(), "vault", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"provider": map[string]interface{}{
+				"vault": map[string]interface{}{
+					"server": "https://vault.example.com",
+					"auth": map[string]interface{}{
+						"tokenSecretRef": map[string]interface{}{"name": "vault-token", "key": "token"},
+					},
+					"caProvider": map[string]interface{}{"type": "ConfigMap", "name": "vault-ca", "key": "ca.crt"},
+				},
+			},
+		},
+	})
+	pSecretStore := syncertesting.NewUnstructured(mappings.SecretStores(), translate.Default.HostName("vault", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("vault", "test", mappings.SecretStores()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"provider": map[string]interface{}{
+				"vault": map[string]interface{}{
+					"server": "https://vault.example.com",
+					"auth": map[string]interface{}{
+						"tokenSecretRef": map[string]interface{}{"name": translate.Default.HostName("vault-token", "test"), "key": "token"},
+					},
+					"caProvider": map[string]interface{}{"type": "ConfigMap", "name": translate.Default.HostName("vault-ca", "test"), "key": "ca.crt"},
+				},
+			},
+		},
+	})
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create external secret",
+			AdjustConfig:        enableExternalSecrets,
+			InitialVirtualState: []runtime.Object{vExternalSecret.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ExternalSecrets(): {pExternalSecret.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewExternalSecrets)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vExternalSecret.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Reject external secret with cluster secret store",
+			AdjustConfig:        enableExternalSecrets,
+			InitialVirtualState: []runtime.Object{vClusterStoreExternalSecret.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ExternalSecrets(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewExternalSecrets)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClusterStoreExternalSecret.DeepCopy()))
+				assert.ErrorContains(t, err, "cluster secret store vault cannot be used")
+			},
+		},
+		{
+			Name:                 "Sync fetched secret back",
+			AdjustConfig:         enableExternalSecrets,
+			InitialVirtualState:  []runtime.Object{vExternalSecret.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pExternalSecretReady.DeepCopy(), pSecret.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ExternalSecrets(): {vExternalSecretReady.DeepCopy()},
+				mappings.Secrets():         {vSecret.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewExternalSecrets)
+				_, err := syncer.(*generic.SpecSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pExternalSecretReady.DeepCopy(), vExternalSecret.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create secret store",
+			AdjustConfig:        enableExternalSecrets,
+			InitialVirtualState: []runtime.Object{vSecretStore.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.SecretStores(): {pSecretStore.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewSecretStores)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vSecretStore.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromSecretStore(t *testing.T) {
+	secretStore := syncertesting.NewUnstructured(mappings.SecretStores(), "aws", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"provider": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"auth": map[string]interface{}{
+						"secretRef": map[string]interface{}{
+							"accessKeyIDSecretRef":     map[string]interface{}{"name": "aws", "key": "access-key"},
+							"secretAccessKeySecretRef": map[string]interface{}{"name": "aws", "key": "secret-key"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromSecretStore, secretStore, "test/aws")
+}
@@ -0,0 +1,182 @@
+package externalsecrets
+
+import (
+	"fmt"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/hostsecrets"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecretController is the controller label value of virtual secrets that were fetched by an external secret in the host cluster
+const SecretController = "external-secrets"
+
+// translateExternalSecretSpec translates the store references and the target secret of an external secret. External
+// secrets may only use secret stores of their own namespace.
+func translateExternalSecretSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	if storeRef, ok := spec["secretStoreRef"].(map[string]interface{}); ok {
+		err := translateStoreRef(ctx, storeRef, vNamespace)
+		if err != nil {
+			return err
+		}
+	}
+	for _, dataFrom := range generic.NestedMaps(spec, "dataFrom") {
+		if storeRef, ok, _ := unstructured.NestedFieldNoCopy(dataFrom, "sourceRef", "storeRef"); ok {
+			if storeRef, ok := storeRef.(map[string]interface{}); ok {
+				err := translateStoreRef(ctx, storeRef, vNamespace)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if generatorRef, ok, _ := unstructured.NestedFieldNoCopy(dataFrom, "sourceRef", "generatorRef"); ok {
+			if generatorRef, ok := generatorRef.(map[string]interface{}); ok {
+				if name, _ := generatorRef["name"].(string); name != "" {
+					generatorRef["name"] = translate.Default.HostName(name, vNamespace)
+				}
+			}
+		}
+	}
+
+	target, ok := spec["target"].(map[string]interface{})
+	if !ok {
+		target = map[string]interface{}{}
+		spec["target"] = target
+	}
+	target["name"] = mappings.VirtualToHostName(ctx, virtualTargetName(vObj), vNamespace, mappings.Secrets())
+	for _, templateFrom := range generic.NestedMaps(target, "template", "templateFrom") {
+		if name, ok, _ := unstructured.NestedString(templateFrom, "configMap", "name"); ok && name != "" {
+			_ = unstructured.SetNestedField(templateFrom, mappings.VirtualToHostName(ctx, name, vNamespace, mappings.ConfigMaps()), "configMap", "name")
+		}
+		if name, ok, _ := unstructured.NestedString(templateFrom, "secret", "name"); ok && name != "" {
+			_ = unstructured.SetNestedField(templateFrom, mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Secrets()), "secret", "name")
+		}
+	}
+
+	return nil
+}
+
+// translateStoreRef translates a secret store reference. Cluster secret stores of the host cluster can't be used, as
+// they would give access to secrets outside of the vCluster.
+func translateStoreRef(ctx *synccontext.SyncContext, storeRef map[string]interface{}, vNamespace string) error {
+	name, _ := storeRef["name"].(string)
+	kind, _ := storeRef["kind"].(string)
+	switch {
+	case name == "":
+		return nil
+	case kind == "ClusterSecretStore":
+		return fmt.Errorf("cluster secret store %s cannot be used within the vCluster, please use a secret store instead", name)
+	case ctx.Mappings.Has(mappings.SecretStores()):
+		storeRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.SecretStores())
+	default:
+		storeRef["name"] = translate.Default.HostName(name, vNamespace)
+	}
+
+	return nil
+}
+
+// syncTargetSecret copies the secret fetched for the host external secret into the virtual namespace of the external secret
+func syncTargetSecret(ctx *synccontext.SyncContext, vExternalSecret, pExternalSecret *unstructured.Unstructured) error {
+	pSecretName, _, _ := unstructured.NestedString(pExternalSecret.Object, "spec", "target", "name")
+	if pSecretName == "" {
+		return nil
+	}
+
+	return hostsecrets.SyncToVirtual(
+		ctx,
+		types.NamespacedName{Namespace: pExternalSecret.GetNamespace(), Name: pSecretName},
+		types.NamespacedName{Namespace: vExternalSecret.GetNamespace(), Name: virtualTargetName(vExternalSecret)},
+		SecretController,
+		vExternalSecret.GetKind()+"/"+vExternalSecret.GetName(),
+	)
+}
+
+// virtualTargetName returns the name of the secret an external secret creates, which defaults to its own name
+func virtualTargetName(vExternalSecret *unstructured.Unstructured) string {
+	name, _, _ := unstructured.NestedString(vExternalSecret.Object, "spec", "target", "name")
+	if name == "" {
+		return vExternalSecret.GetName()
+	}
+
+	return name
+}
+
+// translateSecretStoreSpec translates the secret and config map references of the provider configuration of a secret store
+func translateSecretStoreSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	translateKeySelectors(ctx, spec, vObj.GetNamespace())
+	return nil
+}
+
+// translateKeySelectors translates the names of all key selectors within obj. The providers reference secrets and
+// config maps through selectors that hold a name and a key.
+func translateKeySelectors(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	for _, value := range obj {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if gvk, ok := keySelectorKind(v); ok {
+				v["name"] = mappings.VirtualToHostName(ctx, v["name"].(string), vNamespace, gvk)
+				delete(v, "namespace")
+			} else {
+				translateKeySelectors(ctx, v, vNamespace)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					translateKeySelectors(ctx, m, vNamespace)
+				}
+			}
+		}
+	}
+}
+
+// SecretNamesFromSecretStore returns the namespace/name of the secrets referenced by a secret store
+func SecretNamesFromSecretStore(secretStore *unstructured.Unstructured) []string {
+	spec, _ := secretStore.Object["spec"].(map[string]interface{})
+	return translate.UniqueSlice(secretNamesFromKeySelectors(spec, secretStore.GetNamespace()))
+}
+
+func secretNamesFromKeySelectors(obj map[string]interface{}, namespace string) []string {
+	secrets := []string{}
+	for _, value := range obj {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if gvk, ok := keySelectorKind(v); ok {
+				if gvk == mappings.Secrets() {
+					secrets = append(secrets, namespace+"/"+v["name"].(string))
+				}
+			} else {
+				secrets = append(secrets, secretNamesFromKeySelectors(v, namespace)...)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					secrets = append(secrets, secretNamesFromKeySelectors(m, namespace)...)
+				}
+			}
+		}
+	}
+
+	return secrets
+}
+
+// keySelectorKind returns the kind of object a key selector references. Selectors reference secrets unless
+// their type is ConfigMap.
+func keySelectorKind(obj map[string]interface{}) (schema.GroupVersionKind, bool) {
+	name, _ := obj["name"].(string)
+	_, hasKey := obj["key"].(string)
+	if name == "" || !hasKey {
+		return schema.GroupVersionKind{}, false
+	}
+
+	if objType, _ := obj["type"].(string); objType == "ConfigMap" {
+		return mappings.ConfigMaps(), true
+	}
+
+	return mappings.Secrets(), true
+}
@@ -0,0 +1,84 @@
+package gatewayapi
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewGatewayClasses creates a syncer that mirrors the host gateway classes into the virtual cluster
+func NewGatewayClasses(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.GatewayClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewayClassSyncer{
+		Mapper: mapper,
+	}, nil
+}
+
+type gatewayClassSyncer struct {
+	synccontext.Mapper
+}
+
+func (g *gatewayClassSyncer) Name() string {
+	return "gatewayclass"
+}
+
+func (g *gatewayClassSyncer) Resource() client.Object {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mappings.GatewayClasses())
+	return obj
+}
+
+var _ syncertypes.Syncer = &gatewayClassSyncer{}
+
+func (g *gatewayClassSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*unstructured.Unstructured](g)
+}
+
+func (g *gatewayClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*unstructured.Unstructured]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, types.NamespacedName{Name: event.Host.GetName()}, false)
+	delete(vObj.Object, "status")
+	ctx.Log.Infof("create gateway class %s, because it does not exist in virtual cluster", vObj.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (g *gatewayClassSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*unstructured.Unstructured]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.SetAnnotations(event.Host.GetAnnotations())
+	event.Virtual.SetLabels(event.Host.GetLabels())
+	for _, field := range []string{"spec", "status"} {
+		if value, ok := event.Host.Object[field]; ok {
+			event.Virtual.Object[field] = runtime.DeepCopyJSONValue(value)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (g *gatewayClassSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*unstructured.Unstructured]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual gateway class %s, because physical object is missing", event.Virtual.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
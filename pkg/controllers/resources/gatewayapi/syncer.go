@@ -0,0 +1,42 @@
+package gatewayapi
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewGateways creates a syncer for Gateway API gateways
+func NewGateways(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "gateway", mappings.Gateways(), generic.SpecSyncerOptions{
+		TranslateSpec: translateGatewaySpec,
+		SyncStatus:    true,
+	})
+}
+
+// NewHTTPRoutes creates a syncer for Gateway API http routes
+func NewHTTPRoutes(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "httproute", mappings.HTTPRoutes(), generic.SpecSyncerOptions{
+		TranslateSpec:   translateRouteSpec,
+		SyncStatus:      true,
+		TranslateStatus: translateRouteStatus,
+	})
+}
+
+// NewGRPCRoutes creates a syncer for Gateway API grpc routes
+func NewGRPCRoutes(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "grpcroute", mappings.GRPCRoutes(), generic.SpecSyncerOptions{
+		TranslateSpec:   translateRouteSpec,
+		SyncStatus:      true,
+		TranslateStatus: translateRouteStatus,
+	})
+}
+
+// NewReferenceGrants creates a syncer for Gateway API reference grants
+func NewReferenceGrants(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "referencegrant", mappings.ReferenceGrants(), generic.SpecSyncerOptions{
+		TranslateSpec: translateReferenceGrantSpec,
+		SyncStatus:    true,
+	})
+}
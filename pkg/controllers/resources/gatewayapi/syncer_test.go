@@ -0,0 +1,232 @@
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableGatewayAPI := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.ToHost.Gateways.Enabled = true
+		vConfig.Sync.ToHost.HTTPRoutes.Enabled = true
+		vConfig.Sync.ToHost.ReferenceGrants.Enabled = true
+		vConfig.Sync.FromHost.GatewayClasses.Enabled = true
+	}
+
+	vGateway := syncertesting.NewUnstructured(mappings.Gateways(), "gateway", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"gatewayClassName": "istio",
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"name":     "https",
+					"hostname": "example.com",
+					"port":     int64(443),
+					"protocol": "HTTPS",
+					"tls": map[string]interface{}{
+						"certificateRefs": []interface{}{
+							map[string]interface{}{"name": "tls-secret"},
+						},
+					},
+				},
+			},
+		},
+	})
+	pGateway := syncertesting.NewUnstructured(mappings.Gateways(), translate.Default.HostName("gateway", "test"), "test", syncertesting.HostAnnotations("gateway", "test", mappings.Gateways()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"gatewayClassName": "istio",
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"name":     "https",
+					"hostname": "example.com",
+					"port":     int64(443),
+					"protocol": "HTTPS",
+					"tls": map[string]interface{}{
+						"certificateRefs": []interface{}{
+							map[string]interface{}{"name": translate.Default.HostName("tls-secret", "test")},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	vRoute := syncertesting.NewUnstructured(mappings.HTTPRoutes(), "route", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"hostnames": []interface{}{"example.com"},
+			"parentRefs": []interface{}{
+				map[string]interface{}{"name": "gateway", "namespace": "test"},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"backendRefs": []interface{}{
+						map[string]interface{}{"name": "service", "port": int64(80)},
+						map[string]interface{}{"group": "example.com", "kind": "Other", "name": "other"},
+					},
+				},
+			},
+		},
+	})
+	pRoute := syncertesting.NewUnstructured(mappings.HTTPRoutes(), translate.Default.HostName("route", "test"), "test", syncertesting.HostAnnotations("route", "test", mappings.HTTPRoutes()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"hostnames": []interface{}{"example.com"},
+			"parentRefs": []interface{}{
+				map[string]interface{}{"name": translate.Default.HostName("gateway", "test"), "namespace": "test"},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"backendRefs": []interface{}{
+						map[string]interface{}{"name": translate.Default.HostName("service", "test"), "port": int64(80)},
+						map[string]interface{}{"group": "example.com", "kind": "Other", "name": "other"},
+					},
+				},
+			},
+		},
+	})
+
+	pRouteWithStatus := pRoute.DeepCopy()
+	pRouteWithStatus.Object["status"] = map[string]interface{}{
+		"parents": []interface{}{
+			map[string]interface{}{
+				"controllerName": "istio.io/gateway-controller",
+				"parentRef":      map[string]interface{}{"name": translate.Default.HostName("gateway", "test"), "namespace": "test"},
+			},
+		},
+	}
+	vRouteWithStatus := vRoute.DeepCopy()
+	vRouteWithStatus.Object["status"] = map[string]interface{}{
+		"parents": []interface{}{
+			map[string]interface{}{
+				"controllerName": "istio.io/gateway-controller",
+				"parentRef":      map[string]interface{}{"name": "gateway", "namespace": "test"},
+			},
+		},
+	}
+
+	vReferenceGrant := syncertesting.NewUnstructured(mappings.ReferenceGrants(), "grant", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"from": []interface{}{
+				map[string]interface{}{"group": gatewayAPIGroup, "kind": "HTTPRoute", "namespace": "other"},
+			},
+			"to": []interface{}{
+				map[string]interface{}{"group": "", "kind": "Service", "name": "service"},
+			},
+		},
+	})
+	pReferenceGrant := syncertesting.NewUnstructured(mappings.ReferenceGrants(), translate.Default.HostName("grant", "test"), "test", syncertesting.HostAnnotations("grant", "test", mappings.ReferenceGrants()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"from": []interface{}{
+				map[string]interface{}{"group": gatewayAPIGroup, "kind": "HTTPRoute", "namespace": syncertesting.DefaultTestTargetNamespace},
+			},
+			"to": []interface{}{
+				map[string]interface{}{"group": "", "kind": "Service", "name": translate.Default.HostName("service", "test")},
+			},
+		},
+	})
+
+	pGatewayClass := syncertesting.NewUnstructured(mappings.GatewayClasses(), "istio", "", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"controllerName": "istio.io/gateway-controller",
+		},
+	})
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create gateway",
+			AdjustConfig:        enableGatewayAPI,
+			InitialVirtualState: []runtime.Object{vGateway.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.Gateways(): {pGateway.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewGateways)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vGateway.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create http route",
+			AdjustConfig:        enableGatewayAPI,
+			InitialVirtualState: []runtime.Object{vRoute.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.HTTPRoutes(): {pRoute.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewHTTPRoutes)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vRoute.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync http route status back",
+			AdjustConfig:         enableGatewayAPI,
+			InitialVirtualState:  []runtime.Object{vRoute.DeepCopy(), vGateway.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pRouteWithStatus.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.HTTPRoutes(): {vRouteWithStatus.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.HTTPRoutes(): {pRouteWithStatus.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewHTTPRoutes)
+				_, err := syncer.(*generic.SpecSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pRouteWithStatus.DeepCopy(), vRoute.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create reference grant",
+			AdjustConfig:        enableGatewayAPI,
+			InitialVirtualState: []runtime.Object{vReferenceGrant.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ReferenceGrants(): {pReferenceGrant.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewReferenceGrants)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vReferenceGrant.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Mirror gateway class",
+			AdjustConfig:         enableGatewayAPI,
+			InitialPhysicalState: []runtime.Object{pGatewayClass.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.GatewayClasses(): {pGatewayClass.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewGatewayClasses)
+				_, err := syncer.(*gatewayClassSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pGatewayClass.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromGateway(t *testing.T) {
+	gateway := syncertesting.NewUnstructured(mappings.Gateways(), "gateway", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"tls": map[string]interface{}{
+						"certificateRefs": []interface{}{
+							map[string]interface{}{"name": "a"},
+							map[string]interface{}{"name": "b", "namespace": "other"},
+							map[string]interface{}{"group": "example.com", "kind": "Certificate", "name": "c"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromGateway, gateway, "test/a", "other/b")
+}
@@ -0,0 +1,187 @@
+package gatewayapi
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+// translateGatewaySpec translates the tls certificate references of the gateway listeners.
+// Hostnames are kept as they are, because they refer to the external names the gateway is reachable under.
+func translateGatewaySpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	for _, listener := range generic.NestedMaps(spec, "listeners") {
+		for _, certificateRef := range generic.NestedMaps(listener, "tls", "certificateRefs") {
+			translateRefToHost(ctx, certificateRef, vNamespace, "", "Secret")
+		}
+	}
+
+	return nil
+}
+
+// translateRouteSpec translates the parent and backend references of http and grpc routes
+func translateRouteSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	for _, parentRef := range generic.NestedMaps(spec, "parentRefs") {
+		translateRefToHost(ctx, parentRef, vNamespace, gatewayAPIGroup, "Gateway")
+	}
+
+	for _, rule := range generic.NestedMaps(spec, "rules") {
+		translateFiltersToHost(ctx, rule, vNamespace)
+		for _, backendRef := range generic.NestedMaps(rule, "backendRefs") {
+			translateRefToHost(ctx, backendRef, vNamespace, "", "Service")
+			translateFiltersToHost(ctx, backendRef, vNamespace)
+		}
+	}
+
+	return nil
+}
+
+func translateFiltersToHost(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	for _, filter := range generic.NestedMaps(obj, "filters") {
+		if backendRef, ok, _ := unstructured.NestedMap(filter, "requestMirror", "backendRef"); ok {
+			translateRefToHost(ctx, backendRef, vNamespace, "", "Service")
+			_ = unstructured.SetNestedMap(filter, backendRef, "requestMirror", "backendRef")
+		}
+	}
+}
+
+// translateRouteStatus translates the parent references within the host route status back to the virtual names
+func translateRouteStatus(ctx *synccontext.SyncContext, pObj *unstructured.Unstructured, status map[string]interface{}) error {
+	pNamespace := pObj.GetNamespace()
+	for _, parent := range generic.NestedMaps(status, "parents") {
+		parentRef, ok, _ := unstructured.NestedMap(parent, "parentRef")
+		if !ok {
+			continue
+		}
+
+		translateRefToVirtual(ctx, parentRef, pNamespace, gatewayAPIGroup, "Gateway")
+		_ = unstructured.SetNestedMap(parent, parentRef, "parentRef")
+	}
+
+	return nil
+}
+
+// translateReferenceGrantSpec translates the namespaces a reference grant allows references from and
+// the names of the objects it allows references to
+func translateReferenceGrantSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	for _, from := range generic.NestedMaps(spec, "from") {
+		if namespace, _ := from["namespace"].(string); namespace != "" {
+			from["namespace"] = translate.Default.HostNamespace(namespace)
+		}
+	}
+
+	for _, to := range generic.NestedMaps(spec, "to") {
+		translateRefToHost(ctx, to, vNamespace, "", "")
+	}
+
+	return nil
+}
+
+// translateRefToHost translates the name and namespace of a Gateway API object reference to the host names.
+// References to kinds vCluster doesn't sync are left untouched.
+func translateRefToHost(ctx *synccontext.SyncContext, ref map[string]interface{}, vNamespace, defaultGroup, defaultKind string) {
+	gvk, ok := refGroupVersionKind(ref, defaultGroup, defaultKind)
+	name, _ := ref["name"].(string)
+	if !ok || name == "" {
+		return
+	}
+
+	namespace, _ := ref["namespace"].(string)
+	if namespace == "" {
+		namespace = vNamespace
+	}
+
+	var pName types.NamespacedName
+	if ctx.Mappings.Has(gvk) {
+		pName = mappings.VirtualToHost(ctx, name, namespace, gvk)
+	} else {
+		pName = types.NamespacedName{Name: translate.Default.HostName(name, namespace), Namespace: translate.Default.HostNamespace(namespace)}
+	}
+
+	ref["name"] = pName.Name
+	if _, ok := ref["namespace"]; ok {
+		ref["namespace"] = pName.Namespace
+	}
+}
+
+// translateRefToVirtual translates the name and namespace of a host Gateway API object reference back to the virtual names
+func translateRefToVirtual(ctx *synccontext.SyncContext, ref map[string]interface{}, pNamespace, defaultGroup, defaultKind string) {
+	gvk, ok := refGroupVersionKind(ref, defaultGroup, defaultKind)
+	name, _ := ref["name"].(string)
+	if !ok || name == "" || !ctx.Mappings.Has(gvk) {
+		return
+	}
+
+	namespace, _ := ref["namespace"].(string)
+	if namespace == "" {
+		namespace = pNamespace
+	}
+
+	vName := mappings.HostToVirtual(ctx, name, namespace, nil, gvk)
+	if vName.Name == "" {
+		return
+	}
+
+	ref["name"] = vName.Name
+	if _, ok := ref["namespace"]; ok {
+		ref["namespace"] = vName.Namespace
+	}
+}
+
+// refGroupVersionKind returns the kind of a referenced object if vCluster syncs objects of that kind
+func refGroupVersionKind(ref map[string]interface{}, defaultGroup, defaultKind string) (schema.GroupVersionKind, bool) {
+	group, ok := ref["group"].(string)
+	if !ok {
+		group = defaultGroup
+	}
+	kind, ok := ref["kind"].(string)
+	if !ok {
+		kind = defaultKind
+	}
+
+	switch {
+	case group == "" && kind == "Service":
+		return mappings.Services(), true
+	case group == "" && kind == "Secret":
+		return mappings.Secrets(), true
+	case group == gatewayAPIGroup && kind == "Gateway":
+		return mappings.Gateways(), true
+	case group == gatewayAPIGroup && kind == "HTTPRoute":
+		return mappings.HTTPRoutes(), true
+	case group == gatewayAPIGroup && kind == "GRPCRoute":
+		return mappings.GRPCRoutes(), true
+	}
+
+	return schema.GroupVersionKind{}, false
+}
+
+// SecretNamesFromGateway returns the namespace/name of the secrets referenced by the gateway listeners
+func SecretNamesFromGateway(gateway *unstructured.Unstructured) []string {
+	secrets := []string{}
+	for _, listener := range generic.NestedMaps(gateway.Object, "spec", "listeners") {
+		for _, certificateRef := range generic.NestedMaps(listener, "tls", "certificateRefs") {
+			gvk, ok := refGroupVersionKind(certificateRef, "", "Secret")
+			name, _ := certificateRef["name"].(string)
+			if !ok || gvk != mappings.Secrets() || name == "" {
+				continue
+			}
+
+			namespace, _ := certificateRef["namespace"].(string)
+			if namespace == "" {
+				namespace = gateway.GetNamespace()
+			}
+
+			secrets = append(secrets, namespace+"/"+name)
+		}
+	}
+
+	return translate.UniqueSlice(secrets)
+}
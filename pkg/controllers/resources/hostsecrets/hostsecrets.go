@@ -0,0 +1,77 @@
+package hostsecrets
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// IssuedByAnnotation holds the kind/name of the virtual object a secret was issued for in the host cluster
+var IssuedByAnnotation = "vcluster.loft.sh/issued-by"
+
+// SyncToVirtual copies a secret that was issued by an operator in the host cluster into the virtual cluster. The
+// virtual secret is labeled with the given controller, which excludes it from the secret syncer, so it is never
+// synced back to the host cluster. If the host secret doesn't exist yet, nothing is done.
+func SyncToVirtual(ctx *synccontext.SyncContext, pName, vName types.NamespacedName, controller, issuedBy string) error {
+	pSecret := &corev1.Secret{}
+	err := ctx.PhysicalClient.Get(ctx, pName, pSecret)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	newSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vName.Name,
+			Namespace:   vName.Namespace,
+			Labels:      map[string]string{translate.ControllerLabel: controller},
+			Annotations: map[string]string{IssuedByAnnotation: issuedBy},
+		},
+		Type: pSecret.Type,
+		Data: pSecret.Data,
+	}
+	vSecret := &corev1.Secret{}
+	err = ctx.VirtualClient.Get(ctx, vName, vSecret)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		ctx.Log.Infof("create virtual secret %s, because it was issued for %s", vName.String(), issuedBy)
+		return ctx.VirtualClient.Create(ctx, newSecret)
+	} else if vSecret.Labels[translate.ControllerLabel] != controller {
+		return fmt.Errorf("secret %s already exists and was not issued by %s", vName.String(), controller)
+	}
+
+	// secret types are immutable, so we need to recreate the secret
+	if vSecret.Type != pSecret.Type {
+		ctx.Log.Infof("recreate virtual secret %s, because its type has changed", vName.String())
+		err = ctx.VirtualClient.Delete(ctx, vSecret)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		return ctx.VirtualClient.Create(ctx, newSecret)
+	}
+
+	if apiequality.Semantic.DeepEqual(vSecret.Data, pSecret.Data) && vSecret.Annotations[IssuedByAnnotation] == issuedBy {
+		return nil
+	}
+
+	vSecret.Data = pSecret.Data
+	if vSecret.Annotations == nil {
+		vSecret.Annotations = map[string]string{}
+	}
+	vSecret.Annotations[IssuedByAnnotation] = issuedBy
+	ctx.Log.Infof("update virtual secret %s, because it was reissued for %s", vName.String(), issuedBy)
+	return ctx.VirtualClient.Update(ctx, vSecret)
+}
@@ -49,10 +49,10 @@ func (s *ingressSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccont
 
 	pObj, err := s.translate(ctx, event.Virtual)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator.NewTranslateError(err)
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, pObj, s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, pObj)
 }
 
 func (s *ingressSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*networkingv1.Ingress]) (_ ctrl.Result, retErr error) {
@@ -65,14 +65,18 @@ func (s *ingressSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.Sy
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
-	event.TargetObject().Spec.IngressClassName = event.SourceObject().Spec.IngressClassName
+	// host ingress class names are not copied back if they are mapped, as they differ from the virtual ones
+	if event.Source != synccontext.SyncEventSourceHost || !hasIngressClassMappings(ctx) {
+		event.TargetObject().Spec.IngressClassName = event.SourceObject().Spec.IngressClassName
+	}
 	event.Virtual.Status = event.Host.Status
-	s.translateUpdate(ctx, event.Host, event.Virtual)
+	err = s.translateUpdate(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, translator.NewTranslateError(err)
+	}
+
 	return ctrl.Result{}, nil
 }
 
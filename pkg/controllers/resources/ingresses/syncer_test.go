@@ -3,6 +3,7 @@ package ingresses
 import (
 	"testing"
 
+	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
 	"gotest.tools/assert"
@@ -156,6 +157,27 @@ func TestSync(t *testing.T) {
 		},
 		Status: changedIngressStatus,
 	}
+	mappedIngress := &networkingv1.Ingress{
+		ObjectMeta: vObjectMeta,
+		Spec:       *vBaseSpec.DeepCopy(),
+	}
+	mappedIngress.Spec.IngressClassName = stringPointer("nginx")
+	createdMappedIngress := &networkingv1.Ingress{
+		ObjectMeta: pObjectMeta,
+		Spec:       *pBaseSpec.DeepCopy(),
+	}
+	createdMappedIngress.Spec.IngressClassName = stringPointer("tenant-nginx")
+	annotatedIngress := &networkingv1.Ingress{
+		ObjectMeta: *vObjectMeta.DeepCopy(),
+		Spec:       *vBaseSpec.DeepCopy(),
+	}
+	annotatedIngress.Annotations = map[string]string{IngressClassAnnotation: "nginx"}
+	createdAnnotatedIngress := &networkingv1.Ingress{
+		ObjectMeta: *pObjectMeta.DeepCopy(),
+		Spec:       *pBaseSpec.DeepCopy(),
+	}
+	createdAnnotatedIngress.Annotations[IngressClassAnnotation] = "tenant-nginx"
+	createdAnnotatedIngress.Annotations[translate.ManagedAnnotationsAnnotation] = IngressClassAnnotation
 
 	syncertesting.RunTests(t, []*syncertesting.SyncTest{
 		{
@@ -173,6 +195,79 @@ func TestSync(t *testing.T) {
 				assert.NilError(t, err)
 			},
 		},
+		{
+			Name: "Create forward with mapped ingress class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.Ingresses.IngressClasses.Mappings = map[string]string{"nginx": "tenant-nginx"}
+			},
+			InitialVirtualState: []runtime.Object{mappedIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {mappedIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {createdMappedIngress.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(mappedIngress.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name: "Create forward with legacy ingress class annotation",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.Ingresses.IngressClasses.Mappings = map[string]string{"nginx": "tenant-nginx"}
+			},
+			InitialVirtualState: []runtime.Object{annotatedIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {annotatedIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {createdAnnotatedIngress.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(annotatedIngress.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name: "Create forward with default ingress class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.Ingresses.IngressClasses.Default = "tenant-nginx"
+			},
+			InitialVirtualState: []runtime.Object{baseIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {baseIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {createdMappedIngress.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(baseIngress.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name: "Reject unmapped ingress class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.Ingresses.IngressClasses.Mappings = map[string]string{"traefik": "tenant-traefik"}
+				vConfig.Sync.ToHost.Ingresses.IngressClasses.RejectUnmapped = true
+			},
+			InitialVirtualState: []runtime.Object{mappedIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {mappedIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(mappedIngress.DeepCopy()))
+				assert.ErrorContains(t, err, "ingress class nginx is not mapped to a host ingress class")
+			},
+		},
 		{
 			Name: "Update forward",
 			InitialVirtualState: []runtime.Object{&networkingv1.Ingress{
@@ -2,6 +2,7 @@ package ingresses
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/loft-sh/vcluster/pkg/mappings"
@@ -14,6 +15,7 @@ import (
 )
 
 const (
+	IngressClassAnnotation = "kubernetes.io/ingress.class"
 	AlbConditionAnnotation = "alb.ingress.kubernetes.io/conditions"
 	AlbActionsAnnotation   = "alb.ingress.kubernetes.io/actions"
 	ConditionSuffix        = "/conditions."
@@ -22,8 +24,14 @@ const (
 
 func (s *ingressSyncer) translate(ctx *synccontext.SyncContext, vIngress *networkingv1.Ingress) (*networkingv1.Ingress, error) {
 	newIngress := s.TranslateMetadata(ctx, vIngress).(*networkingv1.Ingress)
-	newIngress.Spec = *translateSpec(ctx, vIngress.Namespace, &vIngress.Spec)
+	spec, err := translateSpec(ctx, vIngress.Namespace, &vIngress.Spec, vIngress.Annotations[IngressClassAnnotation])
+	if err != nil {
+		return nil, err
+	}
+
+	newIngress.Spec = *spec
 	newIngress.Annotations, _ = translateIngressAnnotations(ctx, newIngress.Annotations, vIngress.Namespace)
+	newIngress.Annotations = translateIngressClassAnnotation(ctx, newIngress.Annotations)
 	return newIngress, nil
 }
 
@@ -39,17 +47,60 @@ func (s *ingressSyncer) TranslateMetadataUpdate(ctx *synccontext.SyncContext, vO
 	return translate.HostAnnotations(vIngress, pObj), translate.HostLabels(ctx, vIngress, pObj)
 }
 
-func (s *ingressSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *networkingv1.Ingress) {
-	pObj.Spec = *translateSpec(ctx, vObj.Namespace, &vObj.Spec)
+func (s *ingressSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *networkingv1.Ingress) error {
+	spec, err := translateSpec(ctx, vObj.Namespace, &vObj.Spec, vObj.Annotations[IngressClassAnnotation])
+	if err != nil {
+		return err
+	}
+	pObj.Spec = *spec
 
 	var translatedAnnotations map[string]string
 	translatedAnnotations, pObj.Labels = s.TranslateMetadataUpdate(ctx, vObj, pObj)
 	translatedAnnotations, _ = translateIngressAnnotations(ctx, translatedAnnotations, vObj.Namespace)
-	pObj.Annotations = translatedAnnotations
+	pObj.Annotations = translateIngressClassAnnotation(ctx, translatedAnnotations)
+	return nil
+}
+
+func hasIngressClassMappings(ctx *synccontext.SyncContext) bool {
+	ingressClasses := ctx.Config.Sync.ToHost.Ingresses.IngressClasses
+	return len(ingressClasses.Mappings) > 0 || ingressClasses.Default != ""
 }
 
-func translateSpec(ctx *synccontext.SyncContext, namespace string, vIngressSpec *networkingv1.IngressSpec) *networkingv1.IngressSpec {
+// translateIngressClassAnnotation maps the legacy ingress class annotation to the host ingress class
+func translateIngressClassAnnotation(ctx *synccontext.SyncContext, annotations map[string]string) map[string]string {
+	if annotations[IngressClassAnnotation] == "" {
+		return annotations
+	}
+
+	pIngressClassName, ok := translate.HostClassName(ctx.Config.Sync.ToHost.Ingresses.IngressClasses, annotations[IngressClassAnnotation])
+	if ok {
+		annotations[IngressClassAnnotation] = pIngressClassName
+	}
+
+	return annotations
+}
+
+func translateSpec(ctx *synccontext.SyncContext, namespace string, vIngressSpec *networkingv1.IngressSpec, vIngressClassAnnotation string) (*networkingv1.IngressSpec, error) {
 	retSpec := vIngressSpec.DeepCopy()
+
+	// translate the ingress class. Ingresses that use the legacy annotation are translated
+	// within the annotations and are not defaulted.
+	ingressClasses := ctx.Config.Sync.ToHost.Ingresses.IngressClasses
+	if retSpec.IngressClassName != nil && *retSpec.IngressClassName != "" {
+		pIngressClassName, ok := translate.HostClassName(ingressClasses, *retSpec.IngressClassName)
+		if ok {
+			retSpec.IngressClassName = &pIngressClassName
+		} else if ingressClasses.RejectUnmapped {
+			return nil, fmt.Errorf("ingress class %s is not mapped to a host ingress class", *retSpec.IngressClassName)
+		}
+	} else if vIngressClassAnnotation != "" {
+		if _, ok := translate.HostClassName(ingressClasses, vIngressClassAnnotation); !ok && ingressClasses.RejectUnmapped {
+			return nil, fmt.Errorf("ingress class %s is not mapped to a host ingress class", vIngressClassAnnotation)
+		}
+	} else if ingressClasses.Default != "" {
+		pIngressClassName := ingressClasses.Default
+		retSpec.IngressClassName = &pIngressClassName
+	}
 	if retSpec.DefaultBackend != nil {
 		if retSpec.DefaultBackend.Service != nil && retSpec.DefaultBackend.Service.Name != "" {
 			retSpec.DefaultBackend.Service.Name = mappings.VirtualToHostName(ctx, retSpec.DefaultBackend.Service.Name, namespace, mappings.Services())
@@ -78,7 +129,7 @@ func translateSpec(ctx *synccontext.SyncContext, namespace string, vIngressSpec
 		}
 	}
 
-	return retSpec
+	return retSpec, nil
 }
 
 func getActionOrConditionValue(annotation, actionOrCondition string) string {
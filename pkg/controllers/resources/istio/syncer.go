@@ -0,0 +1,43 @@
+package istio
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewVirtualServices creates a syncer for Istio virtual services
+func NewVirtualServices(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "istio-virtualservice", mappings.IstioVirtualServices(), generic.SpecSyncerOptions{
+		TranslateSpec: translateVirtualServiceSpec,
+	})
+}
+
+// NewDestinationRules creates a syncer for Istio destination rules
+func NewDestinationRules(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "istio-destinationrule", mappings.IstioDestinationRules(), generic.SpecSyncerOptions{
+		TranslateSpec: translateDestinationRuleSpec,
+	})
+}
+
+// NewGateways creates a syncer for Istio gateways
+func NewGateways(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "istio-gateway", mappings.IstioGateways(), generic.SpecSyncerOptions{
+		TranslateSpec: translateGatewaySpec,
+	})
+}
+
+// NewPeerAuthentications creates a syncer for Istio peer authentications
+func NewPeerAuthentications(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "istio-peerauthentication", mappings.IstioPeerAuthentications(), generic.SpecSyncerOptions{
+		TranslateSpec: translatePeerAuthenticationSpec,
+	})
+}
+
+// NewAuthorizationPolicies creates a syncer for Istio authorization policies
+func NewAuthorizationPolicies(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "istio-authorizationpolicy", mappings.IstioAuthorizationPolicies(), generic.SpecSyncerOptions{
+		TranslateSpec: translateAuthorizationPolicySpec,
+	})
+}
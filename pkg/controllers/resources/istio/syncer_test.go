@@ -0,0 +1,256 @@
+package istio
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableIstio := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Integrations.Istio.Enabled = true
+		vConfig.Integrations.Istio.Sync.VirtualServices.Enabled = true
+		vConfig.Integrations.Istio.Sync.DestinationRules.Enabled = true
+		vConfig.Integrations.Istio.Sync.Gateways.Enabled = true
+		vConfig.Integrations.Istio.Sync.PeerAuthentications.Enabled = true
+		vConfig.Integrations.Istio.Sync.AuthorizationPolicies.Enabled = true
+	}
+	hostLabel := func(key string) string {
+		return translate.Default.HostLabel(nil, key)
+	}
+	hostService := func(name, namespace string) string {
+		return translate.Default.HostName(name, namespace) + "." + syncertesting.DefaultTestTargetNamespace + ".svc.cluster.local"
+	}
+
+	vVirtualService := syncertesting.NewUnstructured(mappings.IstioVirtualServices(), "reviews", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"hosts":    []interface{}{"reviews", "bookinfo.example.com"},
+			"gateways": []interface{}{"mesh", "gateway"},
+			"http": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{"sourceLabels": map[string]interface{}{"app": "productpage"}},
+					},
+					"route": []interface{}{
+						map[string]interface{}{"destination": map[string]interface{}{"host": "reviews.test.svc.cluster.local", "subset": "v1"}},
+						map[string]interface{}{"destination": map[string]interface{}{"host": "ratings.other.svc"}},
+					},
+					"mirror": map[string]interface{}{"host": "httpbin.org"},
+				},
+			},
+		},
+	})
+	pVirtualService := syncertesting.NewUnstructured(mappings.IstioVirtualServices(), translate.Default.HostName("reviews", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("reviews", "test", mappings.IstioVirtualServices()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"hosts":    []interface{}{hostService("reviews", "test"), "bookinfo.example.com"},
+			"gateways": []interface{}{"mesh", syncertesting.DefaultTestTargetNamespace + "/" + translate.Default.HostName("gateway", "test")},
+			"exportTo": []interface{}{"."},
+			"http": []interface{}{
+				map[string]interface{}{
+					"match": []interface{}{
+						map[string]interface{}{"sourceLabels": map[string]interface{}{hostLabel("app"): "productpage"}},
+					},
+					"route": []interface{}{
+						map[string]interface{}{"destination": map[string]interface{}{"host": hostService("reviews", "test"), "subset": "v1"}},
+						map[string]interface{}{"destination": map[string]interface{}{"host": hostService("ratings", "other")}},
+					},
+					"mirror": map[string]interface{}{"host": "httpbin.org"},
+				},
+			},
+		},
+	})
+
+	vDestinationRule := syncertesting.NewUnstructured(mappings.IstioDestinationRules(), "reviews", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"host": "reviews",
+			"subsets": []interface{}{
+				map[string]interface{}{"name": "v1", "labels": map[string]interface{}{"version": "v1"}},
+			},
+		},
+	})
+	pDestinationRule := syncertesting.NewUnstructured(mappings.IstioDestinationRules(), translate.Default.HostName("reviews", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("reviews", "test", mappings.IstioDestinationRules()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"host":     hostService("reviews", "test"),
+			"exportTo": []interface{}{"."},
+			"subsets": []interface{}{
+				map[string]interface{}{"name": "v1", "labels": map[string]interface{}{hostLabel("version"): "v1"}},
+			},
+		},
+	})
+
+	vGateway := syncertesting.NewUnstructured(mappings.IstioGateways(), "gateway", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"istio": "ingressgateway"},
+			"servers": []interface{}{
+				map[string]interface{}{
+					"hosts": []interface{}{"*/bookinfo.example.com", "other/reviews"},
+					"tls":   map[string]interface{}{"mode": "SIMPLE", "credentialName": "bookinfo-tls"},
+				},
+			},
+		},
+	})
+	pGateway := syncertesting.NewUnstructured(mappings.IstioGateways(), translate.Default.HostName("gateway", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("gateway", "test", mappings.IstioGateways()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				hostLabel("istio"):    "ingressgateway",
+				translate.MarkerLabel: translate.VClusterName,
+			},
+			"servers": []interface{}{
+				map[string]interface{}{
+					"hosts": []interface{}{"./bookinfo.example.com", syncertesting.DefaultTestTargetNamespace + "/" + hostService("reviews", "other")},
+					"tls":   map[string]interface{}{"mode": "SIMPLE", "credentialName": translate.Default.HostName("bookinfo-tls", "test")},
+				},
+			},
+		},
+	})
+
+	vAuthorizationPolicy := syncertesting.NewUnstructured(mappings.IstioAuthorizationPolicies(), "allow", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"action": "ALLOW",
+			"rules": []interface{}{
+				map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"source": map[string]interface{}{
+								"namespaces": []interface{}{"test", "other"},
+								"principals": []interface{}{"cluster.local/ns/other/sa/client"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	pAuthorizationPolicy := syncertesting.NewUnstructured(mappings.IstioAuthorizationPolicies(), translate.Default.HostName("allow", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("allow", "test", mappings.IstioAuthorizationPolicies()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"action": "ALLOW",
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					translate.MarkerLabel:    translate.VClusterName,
+					translate.NamespaceLabel: "test",
+				},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"source": map[string]interface{}{
+								"namespaces": []interface{}{syncertesting.DefaultTestTargetNamespace},
+								"principals": []interface{}{"cluster.local/ns/" + syncertesting.DefaultTestTargetNamespace + "/sa/client"},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	vPeerAuthentication := syncertesting.NewUnstructured(mappings.IstioPeerAuthentications(), "strict", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "reviews"}},
+			"mtls":     map[string]interface{}{"mode": "STRICT"},
+		},
+	})
+	pPeerAuthentication := syncertesting.NewUnstructured(mappings.IstioPeerAuthentications(), translate.Default.HostName("strict", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("strict", "test", mappings.IstioPeerAuthentications()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					hostLabel("app"):         "reviews",
+					translate.MarkerLabel:    translate.VClusterName,
+					translate.NamespaceLabel: "test",
+				},
+			},
+			"mtls": map[string]interface{}{"mode": "STRICT"},
+		},
+	})
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create virtual service",
+			AdjustConfig:        enableIstio,
+			InitialVirtualState: []runtime.Object{vVirtualService.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.IstioVirtualServices(): {pVirtualService.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewVirtualServices)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vVirtualService.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create destination rule",
+			AdjustConfig:        enableIstio,
+			InitialVirtualState: []runtime.Object{vDestinationRule.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.IstioDestinationRules(): {pDestinationRule.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewDestinationRules)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vDestinationRule.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create gateway",
+			AdjustConfig:        enableIstio,
+			InitialVirtualState: []runtime.Object{vGateway.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.IstioGateways(): {pGateway.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewGateways)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vGateway.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create authorization policy without selector",
+			AdjustConfig:        enableIstio,
+			InitialVirtualState: []runtime.Object{vAuthorizationPolicy.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.IstioAuthorizationPolicies(): {pAuthorizationPolicy.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewAuthorizationPolicies)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vAuthorizationPolicy.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create peer authentication",
+			AdjustConfig:        enableIstio,
+			InitialVirtualState: []runtime.Object{vPeerAuthentication.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.IstioPeerAuthentications(): {pPeerAuthentication.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewPeerAuthentications)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vPeerAuthentication.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromGateway(t *testing.T) {
+	gateway := syncertesting.NewUnstructured(mappings.IstioGateways(), "gateway", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"servers": []interface{}{
+				map[string]interface{}{"tls": map[string]interface{}{"credentialName": "a"}},
+				map[string]interface{}{"tls": map[string]interface{}{"credentialName": "a"}},
+				map[string]interface{}{"hosts": []interface{}{"*"}},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromGateway, gateway, "test/a")
+}
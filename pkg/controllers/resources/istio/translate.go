@@ -0,0 +1,392 @@
+package istio
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"strings"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const defaultClusterDomain = "cluster.local"
+
+// translateVirtualServiceSpec translates the hosts, gateways and route destinations of a virtual service
+func translateVirtualServiceSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	translateHostnames(ctx, spec, "hosts", vNamespace)
+	translateGatewayRefs(ctx, spec, vNamespace)
+	for _, routeType := range []string{"http", "tcp", "tls"} {
+		for _, route := range generic.NestedMaps(spec, routeType) {
+			translateRoute(ctx, route, vNamespace)
+		}
+	}
+
+	if delegate, ok := spec["delegate"].(map[string]interface{}); ok {
+		name, _ := delegate["name"].(string)
+		namespace, _ := delegate["namespace"].(string)
+		if namespace == "" {
+			namespace = vNamespace
+		}
+		if name != "" {
+			pName := mappings.VirtualToHost(ctx, name, namespace, mappings.IstioVirtualServices())
+			delegate["name"] = pName.Name
+			delegate["namespace"] = pName.Namespace
+		}
+	}
+
+	translateExportTo(spec)
+
+	return nil
+}
+
+// translateRoute translates the match conditions and destinations of a http, tcp or tls route
+func translateRoute(ctx *synccontext.SyncContext, route map[string]interface{}, vNamespace string) {
+	for _, match := range generic.NestedMaps(route, "match") {
+		translateGatewayRefs(ctx, match, vNamespace)
+		if sourceLabels, ok := match["sourceLabels"].(map[string]interface{}); ok {
+			match["sourceLabels"] = translateLabelKeys(ctx, sourceLabels)
+		}
+		if sourceNamespace, ok := match["sourceNamespace"].(string); ok && sourceNamespace != "" {
+			match["sourceNamespace"] = translate.Default.HostNamespace(sourceNamespace)
+		}
+	}
+
+	for _, destination := range generic.NestedMaps(route, "route") {
+		translateHostname(ctx, destination, vNamespace, "destination", "host")
+	}
+	translateHostname(ctx, route, vNamespace, "mirror", "host")
+	for _, mirror := range generic.NestedMaps(route, "mirrors") {
+		translateHostname(ctx, mirror, vNamespace, "destination", "host")
+	}
+}
+
+// translateDestinationRuleSpec translates the host, the workload selector and the subset labels of a destination rule
+func translateDestinationRuleSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	translateHostname(ctx, spec, vNamespace, "host")
+	if workloadSelector, ok := spec["workloadSelector"].(map[string]interface{}); ok {
+		translateWorkloadSelector(ctx, workloadSelector, "matchLabels", vNamespace)
+	}
+	for _, subset := range generic.NestedMaps(spec, "subsets") {
+		if subsetLabels, ok := subset["labels"].(map[string]interface{}); ok {
+			subset["labels"] = translateLabelKeys(ctx, subsetLabels)
+		}
+	}
+
+	translateCredentialNames(ctx, spec, vNamespace)
+	translateExportTo(spec)
+
+	return nil
+}
+
+// translateGatewaySpec translates the selector, the server hosts and the tls credentials of a gateway. Gateways
+// select gateway pods of all namespaces, so the selector is only restricted to pods of this vCluster.
+func translateGatewaySpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	translateWorkloadSelector(ctx, spec, "selector", "")
+	for _, server := range generic.NestedMaps(spec, "servers") {
+		hosts, ok, _ := unstructured.NestedStringSlice(server, "hosts")
+		if !ok {
+			continue
+		}
+
+		pHosts := make([]interface{}, 0, len(hosts))
+		for _, host := range hosts {
+			namespace, hostname, found := strings.Cut(host, "/")
+			if !found {
+				pHosts = append(pHosts, translateServiceHostname(ctx, host, vNamespace))
+				continue
+			}
+
+			switch namespace {
+			case ".":
+				hostname = translateServiceHostname(ctx, hostname, vNamespace)
+			case "*":
+				if translate.Default.SingleNamespaceTarget() {
+					namespace = "."
+				}
+			default:
+				hostname = translateServiceHostname(ctx, hostname, namespace)
+				namespace = translate.Default.HostNamespace(namespace)
+			}
+			pHosts = append(pHosts, namespace+"/"+hostname)
+		}
+		server["hosts"] = pHosts
+	}
+
+	translateCredentialNames(ctx, spec, vNamespace)
+
+	return nil
+}
+
+// translatePeerAuthenticationSpec restricts a peer authentication to the pods of its virtual namespace
+func translatePeerAuthenticationSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	translatePolicyTarget(ctx, vNamespace, spec)
+
+	return nil
+}
+
+// translateAuthorizationPolicySpec restricts an authorization policy to the pods of its virtual namespace and
+// translates the source namespaces and principals of its rules
+func translateAuthorizationPolicySpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	translatePolicyTarget(ctx, vNamespace, spec)
+	for _, rule := range generic.NestedMaps(spec, "rules") {
+		for _, from := range generic.NestedMaps(rule, "from") {
+			source, ok := from["source"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, field := range []string{"namespaces", "notNamespaces"} {
+				if namespaces, ok, _ := unstructured.NestedStringSlice(source, field); ok {
+					pNamespaces := []string{}
+					for _, namespace := range namespaces {
+						pNamespaces = append(pNamespaces, translate.Default.HostNamespace(namespace))
+					}
+					source[field] = generic.StringsToInterfaces(translate.UniqueSlice(pNamespaces))
+				}
+			}
+			for _, field := range []string{"principals", "notPrincipals"} {
+				if principals, ok, _ := unstructured.NestedStringSlice(source, field); ok {
+					pPrincipals := []string{}
+					for _, principal := range principals {
+						pPrincipals = append(pPrincipals, translatePrincipal(ctx, principal))
+					}
+					source[field] = generic.StringsToInterfaces(pPrincipals)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// translatePolicyTarget translates the target references of a policy or restricts its workload selector to the pods of
+// its virtual namespace. A policy without selector would otherwise apply to all pods of the host namespace.
+func translatePolicyTarget(ctx *synccontext.SyncContext, vNamespace string, spec map[string]interface{}) {
+	targetRefs := generic.NestedMaps(spec, "targetRefs")
+	if targetRef, ok := spec["targetRef"].(map[string]interface{}); ok {
+		targetRefs = append(targetRefs, targetRef)
+	}
+	if len(targetRefs) > 0 {
+		for _, targetRef := range targetRefs {
+			translateTargetRef(ctx, targetRef, vNamespace)
+		}
+		return
+	}
+
+	selector, ok := spec["selector"].(map[string]interface{})
+	if !ok {
+		selector = map[string]interface{}{}
+		spec["selector"] = selector
+	}
+	translateWorkloadSelector(ctx, selector, "matchLabels", vNamespace)
+}
+
+// translateTargetRef translates the name of a policy target reference. Targets are always within the namespace of the policy.
+func translateTargetRef(ctx *synccontext.SyncContext, targetRef map[string]interface{}, vNamespace string) {
+	name, _ := targetRef["name"].(string)
+	if name == "" {
+		return
+	}
+
+	group, _ := targetRef["group"].(string)
+	kind, _ := targetRef["kind"].(string)
+	switch {
+	case group == "" && kind == "Service":
+		targetRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Services())
+	case group == mappings.Gateways().Group && kind == "Gateway" && ctx.Mappings.Has(mappings.Gateways()):
+		targetRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Gateways())
+	default:
+		targetRef["name"] = translate.Default.HostName(name, vNamespace)
+	}
+	delete(targetRef, "namespace")
+}
+
+// translatePrincipal translates a principal of the form <trust-domain>/ns/<namespace>/sa/<service-account>. The
+// service account is only translated if service accounts are synced to the host cluster.
+func translatePrincipal(ctx *synccontext.SyncContext, principal string) string {
+	parts := strings.Split(principal, "/")
+	if len(parts) != 5 || parts[1] != "ns" || parts[3] != "sa" || strings.Contains(parts[2], "*") {
+		return principal
+	}
+
+	if ctx.Config.Sync.ToHost.ServiceAccounts.Enabled && !strings.Contains(parts[4], "*") {
+		pName := mappings.VirtualToHost(ctx, parts[4], parts[2], mappings.ServiceAccounts())
+		parts[4] = pName.Name
+	}
+	parts[2] = translate.Default.HostNamespace(parts[2])
+	return strings.Join(parts, "/")
+}
+
+// translateWorkloadSelector translates the workload selector labels at field of obj. The selector always selects
+// pods of this vCluster only and if vNamespace is set, only the pods of that virtual namespace.
+func translateWorkloadSelector(ctx *synccontext.SyncContext, obj map[string]interface{}, field, vNamespace string) {
+	vLabels := map[string]string{}
+	if matchLabels, ok, _ := unstructured.NestedStringMap(obj, field); ok {
+		vLabels = matchLabels
+	}
+
+	pLabels := map[string]interface{}{}
+	for key, value := range translate.HostLabelsMap(ctx, vLabels, nil, vNamespace) {
+		pLabels[key] = value
+	}
+	obj[field] = pLabels
+}
+
+// translateLabelKeys translates the keys of workload labels without restricting them any further
+func translateLabelKeys(ctx *synccontext.SyncContext, vLabels map[string]interface{}) map[string]interface{} {
+	pLabels := map[string]interface{}{}
+	for key, value := range vLabels {
+		pLabels[translate.Default.HostLabel(ctx, key)] = value
+	}
+
+	return pLabels
+}
+
+// translateGatewayRefs translates the gateways a virtual service or one of its matches is bound to. The reserved
+// gateway mesh is kept as it is.
+func translateGatewayRefs(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	gateways, ok, _ := unstructured.NestedStringSlice(obj, "gateways")
+	if !ok {
+		return
+	}
+
+	pGateways := make([]interface{}, 0, len(gateways))
+	for _, gateway := range gateways {
+		if gateway == "mesh" {
+			pGateways = append(pGateways, gateway)
+			continue
+		}
+
+		namespace, name, found := strings.Cut(gateway, "/")
+		if !found {
+			namespace, name = vNamespace, gateway
+		}
+		if ctx.Mappings.Has(mappings.IstioGateways()) {
+			pName := mappings.VirtualToHost(ctx, name, namespace, mappings.IstioGateways())
+			pGateways = append(pGateways, pName.Namespace+"/"+pName.Name)
+		} else {
+			pGateways = append(pGateways, translate.Default.HostNamespace(namespace)+"/"+translate.Default.HostName(name, namespace))
+		}
+	}
+	obj["gateways"] = pGateways
+}
+
+// translateHostnames translates the service dns names within the string slice at field of obj
+func translateHostnames(ctx *synccontext.SyncContext, obj map[string]interface{}, field, vNamespace string) {
+	hostnames, ok, _ := unstructured.NestedStringSlice(obj, field)
+	if !ok {
+		return
+	}
+
+	pHostnames := make([]interface{}, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		pHostnames = append(pHostnames, translateServiceHostname(ctx, hostname, vNamespace))
+	}
+	obj[field] = pHostnames
+}
+
+// translateHostname translates the service dns name at the given path of obj
+func translateHostname(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string, fields ...string) {
+	hostname, ok, _ := unstructured.NestedString(obj, fields...)
+	if !ok {
+		return
+	}
+
+	_ = unstructured.SetNestedField(obj, translateServiceHostname(ctx, hostname, vNamespace), fields...)
+}
+
+// translateServiceHostname rewrites the dns name of a virtual service to the fully qualified dns name of the host
+// service. Short names are relative to the namespace of the Istio object. Other hostnames, e.g. external ones or
+// wildcards, are kept as they are.
+func translateServiceHostname(ctx *synccontext.SyncContext, hostname, vNamespace string) string {
+	if hostname == "" || strings.Contains(hostname, "*") {
+		return hostname
+	}
+
+	clusterDomain := defaultClusterDomain
+	if ctx.Config != nil && ctx.Config.Networking.Advanced.ClusterDomain != "" {
+		clusterDomain = ctx.Config.Networking.Advanced.ClusterDomain
+	}
+
+	var name, namespace string
+	parts := strings.Split(strings.TrimSuffix(hostname, "."+clusterDomain), ".")
+	switch {
+	case len(parts) == 1:
+		name, namespace = parts[0], vNamespace
+	case len(parts) == 3 && parts[2] == "svc":
+		name, namespace = parts[0], parts[1]
+	default:
+		return hostname
+	}
+
+	pName := mappings.VirtualToHost(ctx, name, namespace, mappings.Services())
+	return pName.Name + "." + pName.Namespace + ".svc." + clusterDomain
+}
+
+// translateCredentialNames translates the tls credential secrets referenced within obj
+func translateCredentialNames(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	for key, value := range obj {
+		switch v := value.(type) {
+		case string:
+			if key == "credentialName" && v != "" {
+				obj[key] = mappings.VirtualToHostName(ctx, v, vNamespace, mappings.Secrets())
+			}
+		case map[string]interface{}:
+			translateCredentialNames(ctx, v, vNamespace)
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					translateCredentialNames(ctx, m, vNamespace)
+				}
+			}
+		}
+	}
+}
+
+// translateExportTo translates the namespaces a virtual service or destination rule is exported to. If all
+// virtual namespaces are synced into a single host namespace, the object is only exported to that namespace,
+// so it can't affect workloads outside of the vCluster.
+func translateExportTo(spec map[string]interface{}) {
+	exportTo, ok, _ := unstructured.NestedStringSlice(spec, "exportTo")
+	if !ok {
+		if translate.Default.SingleNamespaceTarget() {
+			spec["exportTo"] = []interface{}{"."}
+		}
+		return
+	}
+
+	pExportTo := []string{}
+	for _, namespace := range exportTo {
+		switch namespace {
+		case ".", "~":
+			pExportTo = append(pExportTo, namespace)
+		case "*":
+			if translate.Default.SingleNamespaceTarget() {
+				pExportTo = append(pExportTo, ".")
+			} else {
+				pExportTo = append(pExportTo, namespace)
+			}
+		default:
+			pExportTo = append(pExportTo, translate.Default.HostNamespace(namespace))
+		}
+	}
+	spec["exportTo"] = generic.StringsToInterfaces(translate.UniqueSlice(pExportTo))
+}
+
+// SecretNamesFromGateway returns the namespace/name of the tls credential secrets referenced by the gateway servers
+func SecretNamesFromGateway(gateway *unstructured.Unstructured) []string {
+	secrets := []string{}
+	for _, server := range generic.NestedMaps(gateway.Object, "spec", "servers") {
+		if credentialName, ok, _ := unstructured.NestedString(server, "tls", "credentialName"); ok && credentialName != "" {
+			secrets = append(secrets, gateway.GetNamespace()+"/"+credentialName)
+		}
+	}
+
+	return translate.UniqueSlice(secrets)
+}
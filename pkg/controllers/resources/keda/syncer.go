@@ -0,0 +1,24 @@
+package keda
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewScaledObjects creates a syncer for scaled objects
+func NewScaledObjects(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "keda-scaledobject", mappings.ScaledObjects(), generic.SpecSyncerOptions{
+		TranslateSpec: translateScaledObjectSpec,
+		SyncStatus:    true,
+	})
+}
+
+// NewTriggerAuthentications creates a syncer for trigger authentications
+func NewTriggerAuthentications(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "keda-triggerauthentication", mappings.TriggerAuthentications(), generic.SpecSyncerOptions{
+		TranslateSpec: translateTriggerAuthenticationSpec,
+		SyncStatus:    true,
+	})
+}
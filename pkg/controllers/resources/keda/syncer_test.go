@@ -0,0 +1,194 @@
+package keda
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	mappersgeneric "github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableKEDA := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Integrations.KEDA.Enabled = true
+		vConfig.Integrations.KEDA.Sync.ScaledObjects.Enabled = true
+		vConfig.Integrations.KEDA.Sync.TriggerAuthentications.Enabled = true
+	}
+
+	// deployments aren't synced to the host cluster, so scale a custom resource that is
+	rollouts := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+	syncRollouts := func(ctx *synccontext.RegisterContext) {
+		rollout := &unstructured.Unstructured{}
+		rollout.SetGroupVersionKind(rollouts)
+		mapper, err := mappersgeneric.NewMapper(ctx, rollout, translate.Default.HostName)
+		assert.NilError(t, err)
+		assert.NilError(t, ctx.Mappings.AddMapper(mapper))
+	}
+
+	vScaledObject := syncertesting.NewUnstructured(mappings.ScaledObjects(), "worker", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"apiVersion": "argoproj.io/v1alpha1", "kind": "Rollout", "name": "worker"},
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type":              "rabbitmq",
+					"metadata":          map[string]interface{}{"queueName": "jobs"},
+					"authenticationRef": map[string]interface{}{"name": "rabbitmq"},
+				},
+			},
+		},
+	})
+	pScaledObject := syncertesting.NewUnstructured(mappings.ScaledObjects(), translate.Default.HostName("worker", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("worker", "test", mappings.ScaledObjects()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{"apiVersion": "argoproj.io/v1alpha1", "kind": "Rollout", "name": translate.Default.HostName("worker", "test")},
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type":              "rabbitmq",
+					"metadata":          map[string]interface{}{"queueName": "jobs"},
+					"authenticationRef": map[string]interface{}{"name": translate.Default.HostName("rabbitmq", "test")},
+				},
+			},
+		},
+	})
+
+	pScaledObjectReady := pScaledObject.DeepCopy()
+	pScaledObjectReady.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True"},
+		},
+	}
+	vScaledObjectReady := vScaledObject.DeepCopy()
+	vScaledObjectReady.Object["status"] = runtime.DeepCopyJSONValue(pScaledObjectReady.Object["status"])
+
+	vClusterAuthScaledObject := vScaledObject.DeepCopy()
+	_ = unstructured.SetNestedSlice(vClusterAuthScaledObject.Object, []interface{}{
+		map[string]interface{}{
+			"type":              "rabbitmq",
+			"authenticationRef": map[string]interface{}{"name": "rabbitmq", "kind": "ClusterTriggerAuthentication"},
+		},
+	}, "spec", "triggers")
+
+	vDeploymentScaledObject := vScaledObject.DeepCopy()
+	_ = unstructured.SetNestedMap(vDeploymentScaledObject.Object, map[string]interface{}{"name": "worker"}, "spec", "scaleTargetRef")
+
+	vTriggerAuthentication := syncertesting.NewUnstructured(mappings.TriggerAuthentications(), "rabbitmq", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretTargetRef": []interface{}{
+				map[string]interface{}{"parameter": "host", "name": "rabbitmq", "key": "host"},
+			},
+			"configMapTargetRef": []interface{}{
+				map[string]interface{}{"parameter": "vhost", "name": "rabbitmq-config", "key": "vhost"},
+			},
+		},
+	})
+	pTriggerAuthentication := syncertesting.NewUnstructured(mappings.TriggerAuthentications(), translate.Default.HostName("rabbitmq", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("rabbitmq", "test", mappings.TriggerAuthentications()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretTargetRef": []interface{}{
+				map[string]interface{}{"parameter": "host", "name": translate.Default.HostName("rabbitmq", "test"), "key": "host"},
+			},
+			"configMapTargetRef": []interface{}{
+				map[string]interface{}{"parameter": "vhost", "name": translate.Default.HostName("rabbitmq-config", "test"), "key": "vhost"},
+			},
+		},
+	})
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create scaled object",
+			AdjustConfig:        enableKEDA,
+			InitialVirtualState: []runtime.Object{vScaledObject.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ScaledObjects(): {pScaledObject.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncRollouts(ctx)
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewScaledObjects)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vScaledObject.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Reject scaled object with a deployment as scale target",
+			AdjustConfig:        enableKEDA,
+			InitialVirtualState: []runtime.Object{vDeploymentScaledObject.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ScaledObjects(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewScaledObjects)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vDeploymentScaledObject.DeepCopy()))
+				assert.ErrorContains(t, err, "vCluster doesn't sync apps/v1, Kind=Deployment objects to the host cluster")
+			},
+		},
+		{
+			Name:                "Reject scaled object with cluster trigger authentication",
+			AdjustConfig:        enableKEDA,
+			InitialVirtualState: []runtime.Object{vClusterAuthScaledObject.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ScaledObjects(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncRollouts(ctx)
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewScaledObjects)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClusterAuthScaledObject.DeepCopy()))
+				assert.ErrorContains(t, err, "cluster trigger authentication rabbitmq cannot be used")
+			},
+		},
+		{
+			Name:                 "Sync scaled object status back",
+			AdjustConfig:         enableKEDA,
+			InitialVirtualState:  []runtime.Object{vScaledObject.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pScaledObjectReady.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ScaledObjects(): {vScaledObjectReady.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncRollouts(ctx)
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewScaledObjects)
+				_, err := syncer.(*generic.SpecSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pScaledObjectReady.DeepCopy(), vScaledObject.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create trigger authentication",
+			AdjustConfig:        enableKEDA,
+			InitialVirtualState: []runtime.Object{vTriggerAuthentication.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.TriggerAuthentications(): {pTriggerAuthentication.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewTriggerAuthentications)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vTriggerAuthentication.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromTriggerAuthentication(t *testing.T) {
+	triggerAuthentication := syncertesting.NewUnstructured(mappings.TriggerAuthentications(), "vault", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretTargetRef": []interface{}{
+				map[string]interface{}{"parameter": "host", "name": "rabbitmq", "key": "host"},
+			},
+			"azureKeyVault": map[string]interface{}{
+				"credentials": map[string]interface{}{
+					"clientSecret": map[string]interface{}{
+						"valueFrom": map[string]interface{}{
+							"secretKeyRef": map[string]interface{}{"name": "azure", "key": "secret"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromTriggerAuthentication, triggerAuthentication, "test/rabbitmq", "test/azure")
+}
@@ -0,0 +1,134 @@
+package keda
+
+import (
+	"fmt"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// translateScaledObjectSpec translates the scale target and the trigger authentication references of a scaled object.
+// KEDA scales the target in the host cluster, so only targets whose kind is synced to the host cluster are supported.
+// Deployments and stateful sets are not synced by vCluster, so scaled objects targeting them are rejected.
+func translateScaledObjectSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	if scaleTargetRef, ok := spec["scaleTargetRef"].(map[string]interface{}); ok {
+		err := translateScaleTargetRef(ctx, scaleTargetRef, vNamespace)
+		if err != nil {
+			return err
+		}
+	}
+	if name, ok, _ := unstructured.NestedString(spec, "advanced", "horizontalPodAutoscalerConfig", "name"); ok && name != "" {
+		_ = unstructured.SetNestedField(spec, translate.Default.HostName(name, vNamespace), "advanced", "horizontalPodAutoscalerConfig", "name")
+	}
+	for _, trigger := range generic.NestedMaps(spec, "triggers") {
+		authenticationRef, ok := trigger["authenticationRef"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := authenticationRef["name"].(string)
+		kind, _ := authenticationRef["kind"].(string)
+		switch {
+		case name == "":
+		case kind == "ClusterTriggerAuthentication":
+			return fmt.Errorf("cluster trigger authentication %s cannot be used within the vCluster, please use a trigger authentication instead", name)
+		case ctx.Mappings.Has(mappings.TriggerAuthentications()):
+			authenticationRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.TriggerAuthentications())
+		default:
+			authenticationRef["name"] = translate.Default.HostName(name, vNamespace)
+		}
+	}
+
+	return nil
+}
+
+// translateScaleTargetRef translates the name of the scaled object, which defaults to a deployment. Returns an error
+// if the kind of the target is not synced to the host cluster, as KEDA couldn't find it there.
+func translateScaleTargetRef(ctx *synccontext.SyncContext, scaleTargetRef map[string]interface{}, vNamespace string) error {
+	name, _ := scaleTargetRef["name"].(string)
+	if name == "" {
+		return nil
+	}
+
+	apiVersion, _ := scaleTargetRef["apiVersion"].(string)
+	kind, _ := scaleTargetRef["kind"].(string)
+	if apiVersion == "" {
+		apiVersion = "apps/v1"
+	}
+	if kind == "" {
+		kind = "Deployment"
+	}
+
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	if !ctx.Mappings.Has(gvk) {
+		return fmt.Errorf("scale target %s %s cannot be scaled by KEDA, because vCluster doesn't sync %s objects to the host cluster", kind, name, gvk.String())
+	}
+
+	scaleTargetRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, gvk)
+	return nil
+}
+
+// translateTriggerAuthenticationSpec translates the secret and config map references of a trigger authentication
+func translateTriggerAuthenticationSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	vNamespace := vObj.GetNamespace()
+	for _, secretTargetRef := range generic.NestedMaps(spec, "secretTargetRef") {
+		if name, _ := secretTargetRef["name"].(string); name != "" {
+			secretTargetRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Secrets())
+		}
+	}
+	for _, configMapTargetRef := range generic.NestedMaps(spec, "configMapTargetRef") {
+		if name, _ := configMapTargetRef["name"].(string); name != "" {
+			configMapTargetRef["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.ConfigMaps())
+		}
+	}
+	for _, secretKeyRef := range secretKeyRefs(spec) {
+		secretKeyRef["name"] = mappings.VirtualToHostName(ctx, secretKeyRef["name"].(string), vNamespace, mappings.Secrets())
+	}
+
+	return nil
+}
+
+// SecretNamesFromTriggerAuthentication returns the namespace/name of the secrets referenced by a trigger authentication
+func SecretNamesFromTriggerAuthentication(triggerAuthentication *unstructured.Unstructured) []string {
+	spec, _ := triggerAuthentication.Object["spec"].(map[string]interface{})
+	secrets := []string{}
+	for _, secretTargetRef := range generic.NestedMaps(spec, "secretTargetRef") {
+		if name, _ := secretTargetRef["name"].(string); name != "" {
+			secrets = append(secrets, triggerAuthentication.GetNamespace()+"/"+name)
+		}
+	}
+	for _, secretKeyRef := range secretKeyRefs(spec) {
+		secrets = append(secrets, triggerAuthentication.GetNamespace()+"/"+secretKeyRef["name"].(string))
+	}
+
+	return translate.UniqueSlice(secrets)
+}
+
+// secretKeyRefs returns all secretKeyRef selectors within obj, which are used by the credentials of the secret
+// provider integrations such as azureKeyVault or hashiCorpVault
+func secretKeyRefs(obj map[string]interface{}) []map[string]interface{} {
+	retMaps := []map[string]interface{}{}
+	for key, value := range obj {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if name, _ := v["name"].(string); key == "secretKeyRef" && name != "" {
+				retMaps = append(retMaps, v)
+			} else {
+				retMaps = append(retMaps, secretKeyRefs(v)...)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					retMaps = append(retMaps, secretKeyRefs(m)...)
+				}
+			}
+		}
+	}
+
+	return retMaps
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/persistentvolumes"
 	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/patcher"
@@ -49,6 +50,7 @@ func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 		excludedAnnotations: []string{bindCompletedAnnotation, boundByControllerAnnotation, storageProvisionerAnnotation},
 
 		storageClassesEnabled:    storageClassesEnabled,
+		storageClassMappings:     ctx.Config.Sync.ToHost.PersistentVolumeClaims.StorageClasses,
 		schedulerEnabled:         ctx.Config.ControlPlane.Advanced.VirtualScheduler.Enabled,
 		useFakePersistentVolumes: !ctx.Config.Sync.ToHost.PersistentVolumes.Enabled,
 	}, nil
@@ -60,6 +62,7 @@ type persistentVolumeClaimSyncer struct {
 	excludedAnnotations []string
 
 	storageClassesEnabled    bool
+	storageClassMappings     config.ClassMappings
 	schedulerEnabled         bool
 	useFakePersistentVolumes bool
 }
@@ -93,11 +96,10 @@ func (s *persistentVolumeClaimSyncer) SyncToHost(ctx *synccontext.SyncContext, e
 
 	newPvc, err := s.translate(ctx, event.Virtual)
 	if err != nil {
-		s.EventRecorder().Event(event.Virtual, "Warning", "SyncError", err.Error())
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator.NewTranslateError(err)
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, newPvc, s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, newPvc)
 }
 
 func (s *persistentVolumeClaimSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*corev1.PersistentVolumeClaim]) (_ ctrl.Result, retErr error) {
@@ -147,10 +149,6 @@ func (s *persistentVolumeClaimSyncer) Sync(ctx *synccontext.SyncContext, event *
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
-
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
 	// check backwards update
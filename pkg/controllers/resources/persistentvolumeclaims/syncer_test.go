@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 )
 
 func TestSync(t *testing.T) {
@@ -52,6 +53,18 @@ func TestSync(t *testing.T) {
 	createdPvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: pObjectMeta,
 	}
+	mappedPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: vObjectMeta,
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("standard"),
+		},
+	}
+	createdMappedPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: pObjectMeta,
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("tenant-ssd"),
+		},
+	}
 	deletePvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              vObjectMeta.Name,
@@ -152,6 +165,61 @@ func TestSync(t *testing.T) {
 				assert.NilError(t, err)
 			},
 		},
+		{
+			Name: "Create forward with mapped storage class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.PersistentVolumeClaims.StorageClasses.Mappings = map[string]string{"standard": "tenant-ssd"}
+			},
+			InitialVirtualState: []runtime.Object{mappedPvc},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {mappedPvc},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {createdMappedPvc},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*persistentVolumeClaimSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(mappedPvc.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name: "Create forward with default storage class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.PersistentVolumeClaims.StorageClasses.Default = "tenant-ssd"
+			},
+			InitialVirtualState: []runtime.Object{basePvc},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {basePvc},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {createdMappedPvc},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*persistentVolumeClaimSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(basePvc.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name: "Reject unmapped storage class",
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.ToHost.PersistentVolumeClaims.StorageClasses.Mappings = map[string]string{"gp2": "tenant-ssd"}
+				vConfig.Sync.ToHost.PersistentVolumeClaims.StorageClasses.RejectUnmapped = true
+			},
+			InitialVirtualState: []runtime.Object{mappedPvc},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {mappedPvc},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*persistentVolumeClaimSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(mappedPvc.DeepCopy()))
+				assert.ErrorContains(t, err, "storage class standard is not mapped to a host storage class")
+			},
+		},
 		{
 			Name:                 "Delete forward with create function",
 			InitialVirtualState:  []runtime.Object{basePvc},
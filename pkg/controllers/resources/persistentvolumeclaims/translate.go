@@ -1,6 +1,8 @@
 package persistentvolumeclaims
 
 import (
+	"fmt"
+
 	"github.com/loft-sh/vcluster/pkg/constants"
 	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
@@ -17,7 +19,10 @@ var (
 
 func (s *persistentVolumeClaimSyncer) translate(ctx *synccontext.SyncContext, vPvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
 	pPVC := translate.HostMetadata(ctx, vPvc, s.VirtualToHost(ctx, types.NamespacedName{Name: vPvc.GetName(), Namespace: vPvc.GetNamespace()}, vPvc), s.excludedAnnotations...)
-	s.translateSelector(ctx, pPVC)
+	err := s.translateSelector(ctx, pPVC)
+	if err != nil {
+		return nil, err
+	}
 
 	if vPvc.Annotations[constants.SkipTranslationAnnotation] != "true" {
 		if pPVC.Spec.DataSource != nil {
@@ -45,7 +50,7 @@ func (s *persistentVolumeClaimSyncer) translate(ctx *synccontext.SyncContext, vP
 	return pPVC, nil
 }
 
-func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncContext, vPvc *corev1.PersistentVolumeClaim) {
+func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncContext, vPvc *corev1.PersistentVolumeClaim) error {
 	storageClassName := ""
 	if vPvc.Spec.StorageClassName != nil && *vPvc.Spec.StorageClassName != "" {
 		storageClassName = *vPvc.Spec.StorageClassName
@@ -53,8 +58,22 @@ func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncCon
 		storageClassName = vPvc.Annotations[deprecatedStorageClassAnnotation]
 	}
 
+	// translate storage class if there is a mapping for it. An explicitly empty storage class
+	// disables dynamic provisioning and is therefore never defaulted.
+	mapped := false
+	if storageClassName != "" || vPvc.Spec.StorageClassName == nil {
+		hostStorageClassName, ok := translate.HostClassName(s.storageClassMappings, storageClassName)
+		if ok {
+			delete(vPvc.Annotations, deprecatedStorageClassAnnotation)
+			vPvc.Spec.StorageClassName = &hostStorageClassName
+			mapped = true
+		} else if storageClassName != "" && s.storageClassMappings.RejectUnmapped {
+			return fmt.Errorf("storage class %s is not mapped to a host storage class", storageClassName)
+		}
+	}
+
 	// translate storage class if we manage those in vcluster
-	if s.storageClassesEnabled && storageClassName != "" {
+	if s.storageClassesEnabled && !mapped && storageClassName != "" {
 		translated := translate.Default.HostNameCluster(storageClassName)
 		delete(vPvc.Annotations, deprecatedStorageClassAnnotation)
 		vPvc.Spec.StorageClassName = &translated
@@ -70,7 +89,7 @@ func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncCon
 				vPvc.Spec.VolumeName = translate.Default.HostNameCluster(vPvc.Spec.VolumeName)
 			}
 			// check if the storage class exists in the physical cluster
-			if !s.storageClassesEnabled && storageClassName != "" {
+			if !s.storageClassesEnabled && !mapped && storageClassName != "" {
 				// Should the PVC be dynamically provisioned or not?
 				if vPvc.Spec.Selector == nil && vPvc.Spec.VolumeName == "" {
 					err := ctx.PhysicalClient.Get(ctx, types.NamespacedName{Name: storageClassName}, &storagev1.StorageClass{})
@@ -87,6 +106,8 @@ func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncCon
 			}
 		}
 	}
+
+	return nil
 }
 
 func (s *persistentVolumeClaimSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.PersistentVolumeClaim) {
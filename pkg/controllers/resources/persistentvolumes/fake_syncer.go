@@ -132,9 +132,13 @@ func (r *fakePersistentVolumeSyncer) pvNeeded(ctx *synccontext.SyncContext, pvNa
 }
 
 func CreateFakePersistentVolume(ctx context.Context, virtualClient client.Client, name types.NamespacedName, vPvc *corev1.PersistentVolumeClaim) error {
+	// the fake persistent volume uses the storage class requested within the virtual cluster and not the mapped
+	// host storage class, otherwise the virtual persistent volume claim and volume would not match
 	storageClass := ""
 	if vPvc.Spec.StorageClassName != nil {
 		storageClass = *vPvc.Spec.StorageClassName
+	} else if vPvc.Annotations[corev1.BetaStorageClassAnnotation] != "" {
+		storageClass = vPvc.Annotations[corev1.BetaStorageClassAnnotation]
 	}
 
 	persistentVolume := &corev1.PersistentVolume{
@@ -107,7 +107,7 @@ func (s *persistentVolumeSyncer) SyncToHost(ctx *synccontext.SyncContext, event
 
 	pPv, err := s.translate(ctx, event.Virtual)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator.NewTranslateError(err)
 	}
 
 	ctx.Log.Infof("create physical persistent volume %s, because there is a virtual persistent volume", pPv.Name)
@@ -206,7 +206,7 @@ func (s *persistentVolumeSyncer) Sync(ctx *synccontext.SyncContext, event *syncc
 	// update host object
 	if event.Virtual.Annotations[constants.HostClusterPersistentVolumeAnnotation] == "" {
 		// TODO: translate the storage secrets
-		event.Host.Spec.StorageClassName = mappings.VirtualToHostName(ctx, event.Virtual.Spec.StorageClassName, "", mappings.StorageClasses())
+		event.Host.Spec.StorageClassName = translateStorageClass(ctx, event.Virtual.Spec.StorageClassName)
 		event.Host.Annotations = translate.HostAnnotations(event.Virtual, event.Host, s.excludedAnnotations...)
 		event.Host.Labels = translate.HostLabels(ctx, event.Virtual, event.Host)
 	}
@@ -16,10 +16,22 @@ func (s *persistentVolumeSyncer) translate(ctx *synccontext.SyncContext, vPv *co
 	pPV.Spec.ClaimRef = nil
 
 	// TODO: translate the storage secrets
-	pPV.Spec.StorageClassName = mappings.VirtualToHostName(ctx, vPv.Spec.StorageClassName, "", mappings.StorageClasses())
+	pPV.Spec.StorageClassName = translateStorageClass(ctx, vPv.Spec.StorageClassName)
 	return pPV, nil
 }
 
+func translateStorageClass(ctx *synccontext.SyncContext, vStorageClassName string) string {
+	// check if there is a mapping for the storage class first
+	if vStorageClassName != "" {
+		pStorageClassName, ok := translate.HostClassName(ctx.Config.Sync.ToHost.PersistentVolumeClaims.StorageClasses, vStorageClassName)
+		if ok {
+			return pStorageClassName
+		}
+	}
+
+	return mappings.VirtualToHostName(ctx, vStorageClassName, "", mappings.StorageClasses())
+}
+
 func (s *persistentVolumeSyncer) translateBackwards(pPv *corev1.PersistentVolume, vPvc *corev1.PersistentVolumeClaim) *corev1.PersistentVolume {
 	// build virtual persistent volume
 	vObj := translate.CopyObjectWithName(pPv, types.NamespacedName{Name: pPv.Name}, false)
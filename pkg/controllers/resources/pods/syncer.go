@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/loft-sh/vcluster/pkg/mappings"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/klog/v2"
 
 	translatepods "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/runtimeclasses"
 	"github.com/loft-sh/vcluster/pkg/util/toleration"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -69,6 +71,21 @@ func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 		}
 	}
 
+	// parse allowed runtime classes
+	var allowedRuntimeClasses []string
+	if ctx.Config.Sync.FromHost.RuntimeClasses.Enabled {
+		allowedRuntimeClasses = ctx.Config.Sync.FromHost.RuntimeClasses.Allowed
+	}
+
+	// parse priority class mappings
+	var hostPriorityClasses map[string]string
+	if ctx.Config.Sync.FromHost.PriorityClasses.Enabled {
+		hostPriorityClasses = ctx.Config.Sync.FromHost.PriorityClasses.Mappings
+		if hostPriorityClasses == nil {
+			hostPriorityClasses = map[string]string{}
+		}
+	}
+
 	// get pods mapper
 	podsMapper, err := ctx.Mappings.ByGVK(mappings.Pods())
 	if err != nil {
@@ -97,7 +114,9 @@ func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 		nodeSelector:          nodeSelector,
 		tolerations:           tolerations,
 
-		podSecurityStandard: ctx.Config.Policies.PodSecurityStandard,
+		podSecurityStandard:   ctx.Config.Policies.PodSecurityStandard,
+		allowedRuntimeClasses: allowedRuntimeClasses,
+		hostPriorityClasses:   hostPriorityClasses,
 	}, nil
 }
 
@@ -114,7 +133,9 @@ type podSyncer struct {
 	nodeSelector          *metav1.LabelSelector
 	tolerations           []*corev1.Toleration
 
-	podSecurityStandard string
+	podSecurityStandard   string
+	allowedRuntimeClasses []string
+	hostPriorityClasses   map[string]string
 }
 
 var _ syncertypes.ControllerModifier = &podSyncer{}
@@ -178,10 +199,22 @@ func (s *podSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.
 		}
 	}
 
+	// validate the runtime class of the virtual pod
+	err := s.validateRuntimeClass(event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// validate the priority class of the virtual pod
+	err = s.validatePriorityClass(event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// translate the pod
 	pPod, err := s.translate(ctx, event.Virtual)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator.NewTranslateError(err)
 	}
 
 	// ensure tolerations
@@ -220,7 +253,7 @@ func (s *podSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.
 		return ctrl.Result{}, nil
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, pPod, s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, pPod)
 }
 
 func (s *podSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*corev1.Pod]) (_ ctrl.Result, retErr error) {
@@ -330,10 +363,6 @@ func (s *podSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEv
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
-
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
 	// update the virtual pod if the spec has changed
@@ -434,10 +463,7 @@ func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, pObj *corev1.P
 		},
 	}, metav1.CreateOptions{})
 	if err != nil {
-		if !kerrors.IsConflict(err) {
-			s.EventRecorder().Eventf(vObj, "Warning", "SyncError", "Error binding pod: %v", err)
-		}
-		return err
+		return fmt.Errorf("bind pod: %w", err)
 	}
 
 	// wait until cache is updated
@@ -456,3 +482,25 @@ func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, pObj *corev1.P
 	})
 	return err
 }
+
+// validateRuntimeClass returns an error if the runtime class of the pod is not allowed. The error is surfaced
+// on the virtual pod by the sync controller.
+func (s *podSyncer) validateRuntimeClass(pod *corev1.Pod) error {
+	if pod.Spec.RuntimeClassName == nil || runtimeclasses.IsAllowed(s.allowedRuntimeClasses, *pod.Spec.RuntimeClassName) {
+		return nil
+	}
+
+	return fmt.Errorf("pod %s is forbidden: runtime class %s is not allowed", pod.Name, *pod.Spec.RuntimeClassName)
+}
+
+// validatePriorityClass returns an error if the priority class of the pod is not mapped to a host priority class.
+// Built-in system priority classes are always allowed, as they are removed from the host pod.
+func (s *podSyncer) validatePriorityClass(pod *corev1.Pod) error {
+	if s.hostPriorityClasses == nil || pod.Spec.PriorityClassName == "" || strings.HasPrefix(pod.Spec.PriorityClassName, "system-") {
+		return nil
+	} else if _, ok := s.hostPriorityClasses[pod.Spec.PriorityClassName]; ok {
+		return nil
+	}
+
+	return fmt.Errorf("pod %s is forbidden: priority class %s is not mapped to a host priority class", pod.Name, pod.Spec.PriorityClassName)
+}
@@ -171,6 +171,12 @@ func TestSync(t *testing.T) {
 		},
 	}
 
+	vPodRuntimeClass := vPodPSS.DeepCopy()
+	vPodRuntimeClass.Spec.RuntimeClassName = ptr.To("gvisor")
+
+	vPodPriorityClass := vPodPSS.DeepCopy()
+	vPodPriorityClass.Spec.PriorityClassName = "unmapped"
+
 	vHostpathNamespace := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: syncertesting.DefaultTestCurrentNamespace,
@@ -513,6 +519,42 @@ func TestSync(t *testing.T) {
 				assert.NilError(t, err)
 			},
 		},
+		{
+			Name:                 "Reject pod with runtime class that is not allowed",
+			InitialVirtualState:  []runtime.Object{vPodRuntimeClass.DeepCopy(), vNamespace.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pVclusterService.DeepCopy(), pDNSService.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Pod"): {vPodRuntimeClass.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Pod"): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				ctx.Config.Sync.FromHost.RuntimeClasses.Enabled = true
+				ctx.Config.Sync.FromHost.RuntimeClasses.Allowed = []string{"kata"}
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*podSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vPodRuntimeClass.DeepCopy()))
+				assert.ErrorContains(t, err, "runtime class gvisor is not allowed")
+			},
+		},
+		{
+			Name:                 "Reject pod with priority class that is not mapped",
+			InitialVirtualState:  []runtime.Object{vPodPriorityClass.DeepCopy(), vNamespace.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pVclusterService.DeepCopy(), pDNSService.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Pod"): {vPodPriorityClass.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Pod"): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				ctx.Config.Sync.FromHost.PriorityClasses.Enabled = true
+				ctx.Config.Sync.FromHost.PriorityClasses.Mappings = map[string]string{"high": "tenant-high"}
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*podSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vPodPriorityClass.DeepCopy()))
+				assert.ErrorContains(t, err, "is not mapped to a host priority class")
+			},
+		},
 		{
 			Name:                 "Map hostpaths",
 			InitialVirtualState:  []runtime.Object{vHostPathPod, vHostpathNamespace},
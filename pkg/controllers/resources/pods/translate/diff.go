@@ -16,6 +16,7 @@ func (t *translator) Diff(ctx *synccontext.SyncContext, vPod, pPod *corev1.Pod)
 	oldVPodStatus := vPod.Status.DeepCopy()
 	vPod.Status = *pPod.Status.DeepCopy()
 	stripInjectedSidecarContainers(vPod, pPod)
+	t.translateResourceClaimStatuses(ctx, vPod, pPod)
 	updateConditions(pPod, vPod, oldVPodStatus)
 
 	// get Namespace resource in order to have access to its labels
@@ -99,9 +99,13 @@ func NewTranslator(ctx *synccontext.RegisterContext, eventRecorder record.EventR
 		overrideHostsImage:     ctx.Config.Sync.ToHost.Pods.RewriteHosts.InitContainer.Image,
 		overrideHostsResources: resourceRequirements,
 
-		serviceAccountsEnabled: ctx.Config.Sync.ToHost.ServiceAccounts.Enabled,
-		priorityClassesEnabled: ctx.Config.Sync.ToHost.PriorityClasses.Enabled,
-		enableScheduler:        ctx.Config.ControlPlane.Advanced.VirtualScheduler.Enabled,
+		serviceAccountsEnabled:     ctx.Config.Sync.ToHost.ServiceAccounts.Enabled,
+		priorityClassesEnabled:     ctx.Config.Sync.ToHost.PriorityClasses.Enabled,
+		hostPriorityClassesEnabled: ctx.Config.Sync.FromHost.PriorityClasses.Enabled,
+		enableScheduler:            ctx.Config.ControlPlane.Advanced.VirtualScheduler.Enabled,
+
+		resourceClaimsEnabled:         ctx.Config.Sync.ToHost.ResourceClaims.Enabled,
+		resourceClaimTemplatesEnabled: ctx.Config.Sync.ToHost.ResourceClaimTemplates.Enabled,
 
 		mountPhysicalHostPaths: ctx.Config.ControlPlane.HostPathMapper.Enabled && !ctx.Config.ControlPlane.HostPathMapper.Central,
 
@@ -132,8 +136,12 @@ type translator struct {
 	overrideHostsImage           string
 	overrideHostsResources       corev1.ResourceRequirements
 	priorityClassesEnabled       bool
+	hostPriorityClassesEnabled   bool
 	enableScheduler              bool
 
+	resourceClaimsEnabled         bool
+	resourceClaimTemplatesEnabled bool
+
 	virtualLogsPath       string
 	virtualPodLogsPath    string
 	virtualKubeletPodPath string
@@ -168,7 +176,14 @@ func (t *translator) Translate(ctx *synccontext.SyncContext, vPod *corev1.Pod, s
 	pPod.Spec.EnableServiceLinks = &False
 
 	// check if priority classes are enabled
-	if !t.priorityClassesEnabled {
+	if t.hostPriorityClassesEnabled {
+		// the host admission computes priority and preemption policy from the mapped host priority class
+		if pPod.Spec.PriorityClassName != "" {
+			pPod.Spec.PriorityClassName = mappings.VirtualToHostName(ctx, pPod.Spec.PriorityClassName, "", mappings.PriorityClasses())
+		}
+		pPod.Spec.Priority = nil
+		pPod.Spec.PreemptionPolicy = nil
+	} else if !t.priorityClassesEnabled {
 		pPod.Spec.PriorityClassName = ""
 		pPod.Spec.Priority = nil
 	} else if pPod.Spec.PriorityClassName != "" {
@@ -178,6 +193,9 @@ func (t *translator) Translate(ctx *synccontext.SyncContext, vPod *corev1.Pod, s
 		}
 	}
 
+	// translate dynamic resource allocation claims
+	t.translateResourceClaims(ctx, pPod, vPod)
+
 	// Add an annotation for namespace, name and uid
 	if pPod.Annotations == nil {
 		pPod.Annotations = map[string]string{}
@@ -350,6 +368,38 @@ func (t *translator) Translate(ctx *synccontext.SyncContext, vPod *corev1.Pod, s
 	return pPod, nil
 }
 
+func (t *translator) translateResourceClaims(ctx *synccontext.SyncContext, pPod *corev1.Pod, vPod *corev1.Pod) {
+	for i := range pPod.Spec.ResourceClaims {
+		source := &pPod.Spec.ResourceClaims[i].Source
+		if source.ResourceClaimName != nil && t.resourceClaimsEnabled {
+			source.ResourceClaimName = ptr.To(mappings.VirtualToHostName(ctx, *source.ResourceClaimName, vPod.Namespace, mappings.ResourceClaims()))
+		}
+		if source.ResourceClaimTemplateName != nil && t.resourceClaimTemplatesEnabled {
+			source.ResourceClaimTemplateName = ptr.To(mappings.VirtualToHostName(ctx, *source.ResourceClaimTemplateName, vPod.Namespace, mappings.ResourceClaimTemplates()))
+		}
+	}
+}
+
+// translateResourceClaimStatuses translates the claim names within the host pod status back to the virtual claims.
+// Claims the host generated from a template only exist in the host cluster, so their host name is kept.
+func (t *translator) translateResourceClaimStatuses(ctx *synccontext.SyncContext, vPod, pPod *corev1.Pod) {
+	if !t.resourceClaimsEnabled {
+		return
+	}
+
+	for i := range vPod.Status.ResourceClaimStatuses {
+		claimName := vPod.Status.ResourceClaimStatuses[i].ResourceClaimName
+		if claimName == nil {
+			continue
+		}
+
+		vName := mappings.HostToVirtual(ctx, *claimName, pPod.Namespace, nil, mappings.ResourceClaims())
+		if vName.Name != "" {
+			vPod.Status.ResourceClaimStatuses[i].ResourceClaimName = ptr.To(vName.Name)
+		}
+	}
+}
+
 func canAnnotateOwnerSetKind(kind string) bool {
 	return kind == "DaemonSet" || kind == "Job" || kind == "ReplicaSet" || kind == "StatefulSet"
 }
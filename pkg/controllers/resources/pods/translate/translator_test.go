@@ -11,8 +11,10 @@ import (
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
 	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 )
 
 func TestPodAffinityTermsTranslation(t *testing.T) {
@@ -228,6 +230,60 @@ func TestVolumeTranslation(t *testing.T) {
 	}
 }
 
+func TestResourceClaimTranslation(t *testing.T) {
+	vConfig := generictesting.NewFakeConfig()
+	vConfig.Sync.ToHost.ResourceClaims.Enabled = true
+	vConfig.Sync.ToHost.ResourceClaimTemplates.Enabled = true
+
+	vClaim := &resourcev1alpha2.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu-claim",
+			Namespace: "test-ns",
+		},
+	}
+	vPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "test-ns",
+		},
+		Spec: corev1.PodSpec{
+			ResourceClaims: []corev1.PodResourceClaim{
+				{Name: "shared", Source: corev1.ClaimSource{ResourceClaimName: ptr.To("gpu-claim")}},
+				{Name: "dedicated", Source: corev1.ClaimSource{ResourceClaimTemplateName: ptr.To("gpu-template")}},
+			},
+		},
+	}
+
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, vClaim)
+	registerCtx := generictesting.NewFakeRegisterContext(vConfig, pClient, vClient)
+	syncCtx := registerCtx.ToSyncContext("pods-syncer-translator-test")
+	tr := &translator{
+		resourceClaimsEnabled:         true,
+		resourceClaimTemplatesEnabled: true,
+	}
+
+	pPod := vPod.DeepCopy()
+	pPod.Namespace = generictesting.DefaultTestTargetNamespace
+	tr.translateResourceClaims(syncCtx, pPod, vPod)
+	assert.DeepEqual(t, pPod.Spec.ResourceClaims, []corev1.PodResourceClaim{
+		{Name: "shared", Source: corev1.ClaimSource{ResourceClaimName: ptr.To(translate.Default.HostName("gpu-claim", "test-ns"))}},
+		{Name: "dedicated", Source: corev1.ClaimSource{ResourceClaimTemplateName: ptr.To(translate.Default.HostName("gpu-template", "test-ns"))}},
+	})
+
+	// claims generated from a template only exist in the host cluster and keep their host name
+	pPod.Status.ResourceClaimStatuses = []corev1.PodResourceClaimStatus{
+		{Name: "shared", ResourceClaimName: ptr.To(translate.Default.HostName("gpu-claim", "test-ns"))},
+		{Name: "dedicated", ResourceClaimName: ptr.To("generated-claim")},
+	}
+	vPod.Status = *pPod.Status.DeepCopy()
+	tr.translateResourceClaimStatuses(syncCtx, vPod, pPod)
+	assert.DeepEqual(t, vPod.Status.ResourceClaimStatuses, []corev1.PodResourceClaimStatus{
+		{Name: "shared", ResourceClaimName: ptr.To("gpu-claim")},
+		{Name: "dedicated", ResourceClaimName: ptr.To("generated-claim")},
+	})
+}
+
 type translatePodVolumesTestCase struct {
 	name            string
 	vPod            corev1.Pod
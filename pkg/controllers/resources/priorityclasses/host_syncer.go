@@ -0,0 +1,103 @@
+package priorityclasses
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultMaxValue = int32(1000000000)
+
+// NewHostPriorityClassSyncer creates a syncer that syncs the host priority classes from the
+// sync.fromHost.priorityClasses.mappings table into the virtual cluster under their virtual name
+func NewHostPriorityClassSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.PriorityClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	maxValue := ctx.Config.Sync.FromHost.PriorityClasses.MaxValue
+	if maxValue == 0 {
+		maxValue = defaultMaxValue
+	}
+
+	return &hostPriorityClassSyncer{
+		Mapper: mapper,
+
+		maxValue: maxValue,
+	}, nil
+}
+
+type hostPriorityClassSyncer struct {
+	synccontext.Mapper
+
+	maxValue int32
+}
+
+func (s *hostPriorityClassSyncer) Name() string {
+	return "host-priorityclass"
+}
+
+func (s *hostPriorityClassSyncer) Resource() client.Object {
+	return &schedulingv1.PriorityClass{}
+}
+
+var _ syncertypes.Syncer = &hostPriorityClassSyncer{}
+
+func (s *hostPriorityClassSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*schedulingv1.PriorityClass](s)
+}
+
+func (s *hostPriorityClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*schedulingv1.PriorityClass]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, s.HostToVirtual(ctx, types.NamespacedName{Name: event.Host.Name}, event.Host), false)
+	vObj.Value = s.translateValue(event.Host.Value)
+	ctx.Log.Infof("create priority class %s, because it does not exist in virtual cluster", vObj.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (s *hostPriorityClassSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*schedulingv1.PriorityClass]) (_ ctrl.Result, retErr error) {
+	// value is immutable, so we have to recreate the virtual priority class if the clamped value changed
+	if event.Virtual.Value != s.translateValue(event.Host.Value) {
+		ctx.Log.Infof("recreate priority class %s, because value has changed", event.Virtual.Name)
+		return ctrl.Result{Requeue: true}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+	}
+
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.Annotations = event.Host.Annotations
+	event.Virtual.Labels = event.Host.Labels
+	event.Virtual.GlobalDefault = event.Host.GlobalDefault
+	event.Virtual.Description = event.Host.Description
+	return ctrl.Result{}, nil
+}
+
+func (s *hostPriorityClassSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*schedulingv1.PriorityClass]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual priority class %s, because physical object is missing", event.Virtual.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
+
+func (s *hostPriorityClassSyncer) translateValue(value int32) int32 {
+	if value > s.maxValue {
+		return s.maxValue
+	}
+
+	return value
+}
@@ -0,0 +1,88 @@
+package priorityclasses
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"gotest.tools/assert"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestHostSync(t *testing.T) {
+	mapPriorityClasses := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.FromHost.PriorityClasses.Enabled = true
+		vConfig.Sync.FromHost.PriorityClasses.Mappings = map[string]string{"high": "tenant-high"}
+		vConfig.Sync.FromHost.PriorityClasses.MaxValue = 1000
+	}
+
+	pObj := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tenant-high",
+		},
+		Value:       100000,
+		Description: "high priority tenant workloads",
+	}
+	vObj := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "high",
+		},
+		Value:       1000,
+		Description: "high priority tenant workloads",
+	}
+	pObjUpdated := pObj.DeepCopy()
+	pObjUpdated.Description = "updated"
+	vObjUpdated := vObj.DeepCopy()
+	vObjUpdated.Description = "updated"
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                 "Import mapped priority class",
+			AdjustConfig:         mapPriorityClasses,
+			InitialPhysicalState: []runtime.Object{pObj.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.PriorityClasses(): {vObj.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewHostPriorityClassSyncer)
+				_, err := syncer.(*hostPriorityClassSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pObj.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update mapped priority class",
+			AdjustConfig:         mapPriorityClasses,
+			InitialVirtualState:  []runtime.Object{vObj.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pObjUpdated.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.PriorityClasses(): {vObjUpdated.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewHostPriorityClassSyncer)
+				_, err := syncer.(*hostPriorityClassSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pObjUpdated.DeepCopy(), vObj.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:         "Map names",
+			AdjustConfig: mapPriorityClasses,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewHostPriorityClassSyncer)
+				hostSyncer := syncer.(*hostPriorityClassSyncer)
+				assert.Equal(t, hostSyncer.VirtualToHost(syncCtx, types.NamespacedName{Name: "high"}, nil).Name, "tenant-high")
+				assert.Equal(t, hostSyncer.VirtualToHost(syncCtx, types.NamespacedName{Name: "unmapped"}, nil).Name, "")
+				assert.Equal(t, hostSyncer.HostToVirtual(syncCtx, types.NamespacedName{Name: "tenant-high"}, nil).Name, "high")
+
+				managed, err := hostSyncer.IsManaged(syncCtx, &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "cluster-critical"}})
+				assert.NilError(t, err)
+				assert.Equal(t, managed, false)
+			},
+		},
+	})
+}
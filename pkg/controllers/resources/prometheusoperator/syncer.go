@@ -0,0 +1,28 @@
+package prometheusoperator
+
+import (
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+)
+
+// NewServiceMonitors creates a syncer for Prometheus Operator service monitors
+func NewServiceMonitors(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "servicemonitor", mappings.ServiceMonitors(), generic.SpecSyncerOptions{
+		TranslateSpec: translateServiceMonitorSpec,
+	})
+}
+
+// NewPodMonitors creates a syncer for Prometheus Operator pod monitors
+func NewPodMonitors(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "podmonitor", mappings.PodMonitors(), generic.SpecSyncerOptions{
+		TranslateSpec: translatePodMonitorSpec,
+	})
+}
+
+// NewPrometheusRules creates a syncer for Prometheus Operator rules. Rules don't reference any objects, so the spec
+// is synced as is.
+func NewPrometheusRules(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return generic.NewSpecSyncer(ctx, "prometheusrule", mappings.PrometheusRules(), generic.SpecSyncerOptions{})
+}
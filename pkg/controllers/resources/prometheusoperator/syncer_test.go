@@ -0,0 +1,230 @@
+package prometheusoperator
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enablePrometheusOperator := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Integrations.PrometheusOperator.Enabled = true
+		vConfig.Integrations.PrometheusOperator.Sync.ServiceMonitors.Enabled = true
+		vConfig.Integrations.PrometheusOperator.Sync.PodMonitors.Enabled = true
+		vConfig.Integrations.PrometheusOperator.Sync.PrometheusRules.Enabled = true
+	}
+	hostLabel := func(key string) string {
+		return translate.Default.HostLabel(nil, key)
+	}
+
+	vServiceMonitor := syncertesting.NewUnstructured(mappings.ServiceMonitors(), "monitor", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"jobLabel":     "app",
+			"targetLabels": []interface{}{"team"},
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "web"},
+			},
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"port":     "metrics",
+					"proxyUrl": "http://proxy.example.com:3128",
+					"bearerTokenSecret": map[string]interface{}{
+						"name": "token",
+						"key":  "token",
+					},
+				},
+			},
+		},
+	})
+	pServiceMonitor := syncertesting.NewUnstructured(mappings.ServiceMonitors(), translate.Default.HostName("monitor", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("monitor", "test", mappings.ServiceMonitors()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"jobLabel": hostLabel("app"),
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{syncertesting.DefaultTestTargetNamespace},
+			},
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					hostLabel("app"):         "web",
+					translate.MarkerLabel:    translate.VClusterName,
+					translate.NamespaceLabel: "test",
+				},
+			},
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"port": "metrics",
+					"bearerTokenSecret": map[string]interface{}{
+						"name": translate.Default.HostName("token", "test"),
+						"key":  "token",
+					},
+					"relabelings": []interface{}{
+						map[string]interface{}{
+							"action":       "replace",
+							"sourceLabels": []interface{}{serviceLabelPrefix + sanitizeLabelName(hostLabel("team"))},
+							"targetLabel":  "team",
+							"regex":        "(.+)",
+							"replacement":  "${1}",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	vPodMonitor := syncertesting.NewUnstructured(mappings.PodMonitors(), "monitor", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{"test", "other"},
+			},
+			"selector": map[string]interface{}{},
+			"podMetricsEndpoints": []interface{}{
+				map[string]interface{}{"port": "metrics"},
+			},
+		},
+	})
+	pPodMonitor := syncertesting.NewUnstructured(mappings.PodMonitors(), translate.Default.HostName("monitor", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("monitor", "test", mappings.PodMonitors()), syncertesting.HostLabels("test"), map[string]interface{}{
+		"spec": map[string]interface{}{
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{syncertesting.DefaultTestTargetNamespace},
+			},
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					translate.MarkerLabel: translate.VClusterName,
+				},
+				"matchExpressions": []interface{}{
+					map[string]interface{}{
+						"key":      translate.NamespaceLabel,
+						"operator": "In",
+						"values":   []interface{}{"test", "other"},
+					},
+				},
+			},
+			"podMetricsEndpoints": []interface{}{
+				map[string]interface{}{"port": "metrics"},
+			},
+		},
+	})
+
+	rulesSpec := map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{
+				"name": "example",
+				"rules": []interface{}{
+					map[string]interface{}{"alert": "Down", "expr": "up == 0"},
+				},
+			},
+		},
+	}
+	vRule := syncertesting.NewUnstructured(mappings.PrometheusRules(), "rules", "test", nil, nil, map[string]interface{}{"spec": rulesSpec})
+	pRule := syncertesting.NewUnstructured(mappings.PrometheusRules(), translate.Default.HostName("rules", "test"), syncertesting.DefaultTestTargetNamespace, syncertesting.HostAnnotations("rules", "test", mappings.PrometheusRules()), syncertesting.HostLabels("test"), map[string]interface{}{"spec": runtime.DeepCopyJSON(rulesSpec)})
+
+	vServiceMonitorAny := vServiceMonitor.DeepCopy()
+	vServiceMonitorAny.Object["spec"].(map[string]interface{})["namespaceSelector"] = map[string]interface{}{"any": true}
+	pServiceMonitorAny := pServiceMonitor.DeepCopy()
+	delete(pServiceMonitorAny.Object["spec"].(map[string]interface{})["selector"].(map[string]interface{})["matchLabels"].(map[string]interface{}), translate.NamespaceLabel)
+
+	vServiceMonitorAddress := vServiceMonitor.DeepCopy()
+	vServiceMonitorAddress.Object["spec"].(map[string]interface{})["endpoints"].([]interface{})[0].(map[string]interface{})["relabelings"] = []interface{}{
+		map[string]interface{}{
+			"action":      "replace",
+			"targetLabel": "__address__",
+			"replacement": "other-vcluster-service.other-namespace:8080",
+		},
+	}
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create service monitor",
+			AdjustConfig:        enablePrometheusOperator,
+			InitialVirtualState: []runtime.Object{vServiceMonitor.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ServiceMonitors(): {pServiceMonitor.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewServiceMonitors)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vServiceMonitor.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create service monitor selecting any namespace",
+			AdjustConfig:        enablePrometheusOperator,
+			InitialVirtualState: []runtime.Object{vServiceMonitorAny.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ServiceMonitors(): {pServiceMonitorAny.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewServiceMonitors)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vServiceMonitorAny.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Reject service monitor relabeling the scrape address",
+			AdjustConfig:        enablePrometheusOperator,
+			InitialVirtualState: []runtime.Object{vServiceMonitorAddress.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ServiceMonitors(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewServiceMonitors)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vServiceMonitorAddress.DeepCopy()))
+				assert.ErrorContains(t, err, "endpoints[0]: relabelings[0]: target label __address__ is not allowed")
+			},
+		},
+		{
+			Name:                "Create pod monitor",
+			AdjustConfig:        enablePrometheusOperator,
+			InitialVirtualState: []runtime.Object{vPodMonitor.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.PodMonitors(): {pPodMonitor.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewPodMonitors)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vPodMonitor.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create prometheus rule",
+			AdjustConfig:        enablePrometheusOperator,
+			InitialVirtualState: []runtime.Object{vRule.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.PrometheusRules(): {pRule.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewPrometheusRules)
+				_, err := syncer.(*generic.SpecSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vRule.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestSecretNamesFromMonitor(t *testing.T) {
+	monitor := syncertesting.NewUnstructured(mappings.ServiceMonitors(), "monitor", "test", nil, nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"basicAuth": map[string]interface{}{
+						"username": map[string]interface{}{"name": "auth", "key": "username"},
+						"password": map[string]interface{}{"name": "auth", "key": "password"},
+					},
+					"tlsConfig": map[string]interface{}{
+						"ca":        map[string]interface{}{"configMap": map[string]interface{}{"name": "ca", "key": "ca.crt"}},
+						"keySecret": map[string]interface{}{"name": "tls", "key": "tls.key"},
+					},
+				},
+			},
+		},
+	})
+
+	syncertesting.AssertSecretNames(t, SecretNamesFromMonitor, monitor, "test/auth", "test/tls")
+}
@@ -0,0 +1,246 @@
+package prometheusoperator
+
+import (
+	"fmt"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"regexp"
+	"strings"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	serviceLabelPrefix = "__meta_kubernetes_service_label_"
+	podLabelPrefix     = "__meta_kubernetes_pod_label_"
+)
+
+// secretRefFields are the fields of Prometheus Operator endpoints that hold a secret key selector
+var secretRefFields = map[string]bool{
+	"bearerTokenSecret": true,
+	"username":          true,
+	"password":          true,
+	"credentials":       true,
+	"clientSecret":      true,
+	"keySecret":         true,
+	"secret":            true,
+}
+
+// proxyURLFields are the fields of Prometheus Operator endpoints that let Prometheus scrape through a proxy
+var proxyURLFields = []string{"proxyUrl", "proxyURL"}
+
+// invalidLabelNameChars matches the characters Prometheus replaces when converting kubernetes labels to label names
+var invalidLabelNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// translateServiceMonitorSpec translates the service selector, the target labels and the secret references of a
+// service monitor and restricts it to services synced by this vCluster
+func translateServiceMonitorSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	err := translateMonitorSpec(ctx, vObj.GetNamespace(), spec, "endpoints")
+	if err != nil {
+		return err
+	}
+
+	if jobLabel, ok := spec["jobLabel"].(string); ok && jobLabel != "" {
+		spec["jobLabel"] = translate.Default.HostLabel(ctx, jobLabel)
+	}
+	translateTargetLabels(ctx, spec, "targetLabels", "endpoints", serviceLabelPrefix)
+	translateTargetLabels(ctx, spec, "podTargetLabels", "endpoints", podLabelPrefix)
+	return nil
+}
+
+// translatePodMonitorSpec translates the pod selector, the target labels and the secret references of a
+// pod monitor and restricts it to pods synced by this vCluster
+func translatePodMonitorSpec(ctx *synccontext.SyncContext, vObj *unstructured.Unstructured, spec map[string]interface{}) error {
+	err := translateMonitorSpec(ctx, vObj.GetNamespace(), spec, "podMetricsEndpoints")
+	if err != nil {
+		return err
+	}
+
+	if jobLabel, ok := spec["jobLabel"].(string); ok && jobLabel != "" {
+		spec["jobLabel"] = translate.Default.HostLabel(ctx, jobLabel)
+	}
+	translateTargetLabels(ctx, spec, "podTargetLabels", "podMetricsEndpoints", podLabelPrefix)
+	return nil
+}
+
+// translateMonitorSpec translates the selector and namespace selector of a monitor. The selector is always
+// restricted to objects of this vCluster within the namespaces the virtual monitor selects, so a monitor
+// can never select host objects that don't belong to this vCluster. For the same reason, endpoints can neither
+// scrape through a proxy nor relabel the scrape address of a target.
+func translateMonitorSpec(ctx *synccontext.SyncContext, vNamespace string, spec map[string]interface{}, endpointsField string) error {
+	anyNamespace, _, _ := unstructured.NestedBool(spec, "namespaceSelector", "any")
+	matchNames, _, _ := unstructured.NestedStringSlice(spec, "namespaceSelector", "matchNames")
+
+	restriction := &metav1.LabelSelector{MatchLabels: map[string]string{translate.MarkerLabel: translate.VClusterName}}
+	hostNamespaces := []string{}
+	switch {
+	case anyNamespace:
+		if translate.Default.SingleNamespaceTarget() {
+			hostNamespaces = append(hostNamespaces, translate.Default.HostNamespace(vNamespace))
+		}
+	case len(matchNames) > 0:
+		restriction.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: translate.NamespaceLabel, Operator: metav1.LabelSelectorOpIn, Values: matchNames}}
+		for _, matchName := range matchNames {
+			hostNamespaces = append(hostNamespaces, translate.Default.HostNamespace(matchName))
+		}
+	default:
+		restriction.MatchLabels[translate.NamespaceLabel] = vNamespace
+		hostNamespaces = append(hostNamespaces, translate.Default.HostNamespace(vNamespace))
+	}
+	if len(hostNamespaces) > 0 {
+		spec["namespaceSelector"] = map[string]interface{}{"matchNames": generic.StringsToInterfaces(translate.UniqueSlice(hostNamespaces))}
+	}
+
+	selector := &metav1.LabelSelector{}
+	if vSelector, ok, _ := unstructured.NestedMap(spec, "selector"); ok {
+		// an invalid selector is replaced by the restriction only, as the api server should have rejected it already
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(vSelector, selector); err == nil {
+			selector = translate.HostLabelSelector(ctx, selector)
+		}
+	}
+	pSelector, err := runtime.DefaultUnstructuredConverter.ToUnstructured(translate.MergeLabelSelectors(selector, restriction))
+	if err == nil {
+		spec["selector"] = pSelector
+	}
+
+	for i, endpoint := range generic.NestedMaps(spec, endpointsField) {
+		err := validateRelabelings(endpoint)
+		if err != nil {
+			return fmt.Errorf("%s[%d]: %w", endpointsField, i, err)
+		}
+
+		for _, field := range proxyURLFields {
+			delete(endpoint, field)
+		}
+		translateRefsToHost(ctx, endpoint, vNamespace)
+	}
+
+	return nil
+}
+
+// validateRelabelings makes sure the relabelings of an endpoint don't change which target gets scraped. Relabelings
+// that write to __address__ or the __meta_* discovery labels, read the discovery labels or map label names are
+// rejected, as these could point the scrape to an object outside of this vCluster.
+func validateRelabelings(endpoint map[string]interface{}) error {
+	for i, relabeling := range generic.NestedMaps(endpoint, "relabelings") {
+		if action, _ := relabeling["action"].(string); strings.EqualFold(action, "labelmap") {
+			return fmt.Errorf("relabelings[%d]: action labelmap is not allowed", i)
+		}
+		if targetLabel, _ := relabeling["targetLabel"].(string); targetLabel == "__address__" || strings.HasPrefix(targetLabel, "__meta_") {
+			return fmt.Errorf("relabelings[%d]: target label %s is not allowed", i, targetLabel)
+		}
+
+		sourceLabels, _, _ := unstructured.NestedStringSlice(relabeling, "sourceLabels")
+		for _, sourceLabel := range sourceLabels {
+			if strings.HasPrefix(sourceLabel, "__meta_") {
+				return fmt.Errorf("relabelings[%d]: source label %s is not allowed", i, sourceLabel)
+			}
+		}
+	}
+
+	return nil
+}
+
+// translateTargetLabels translates the labels that are transferred from the scraped object to the metrics. As label keys
+// might be rewritten in the host cluster, a relabeling is added to each endpoint that keeps the virtual label name.
+func translateTargetLabels(ctx *synccontext.SyncContext, spec map[string]interface{}, field, endpointsField, prefix string) {
+	targetLabels, ok, _ := unstructured.NestedStringSlice(spec, field)
+	if !ok {
+		return
+	}
+
+	keptLabels := []interface{}{}
+	relabelings := []interface{}{}
+	for _, targetLabel := range targetLabels {
+		pTargetLabel := translate.Default.HostLabel(ctx, targetLabel)
+		if pTargetLabel == targetLabel {
+			keptLabels = append(keptLabels, targetLabel)
+			continue
+		}
+
+		relabelings = append(relabelings, map[string]interface{}{
+			"action":       "replace",
+			"sourceLabels": []interface{}{prefix + sanitizeLabelName(pTargetLabel)},
+			"targetLabel":  sanitizeLabelName(targetLabel),
+			"regex":        "(.+)",
+			"replacement":  "${1}",
+		})
+	}
+	if len(keptLabels) > 0 {
+		spec[field] = keptLabels
+	} else {
+		delete(spec, field)
+	}
+	if len(relabelings) == 0 {
+		return
+	}
+
+	for _, endpoint := range generic.NestedMaps(spec, endpointsField) {
+		existing, _ := endpoint["relabelings"].([]interface{})
+		endpoint["relabelings"] = append(runtime.DeepCopyJSONValue(relabelings).([]interface{}), existing...)
+	}
+}
+
+// translateRefsToHost translates the names of all secrets and config maps referenced within obj
+func translateRefsToHost(ctx *synccontext.SyncContext, obj map[string]interface{}, vNamespace string) {
+	for key, value := range obj {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if name, ok := v["name"].(string); ok && name != "" && secretRefFields[key] {
+				v["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.Secrets())
+			} else if ok && name != "" && key == "configMap" {
+				v["name"] = mappings.VirtualToHostName(ctx, name, vNamespace, mappings.ConfigMaps())
+			} else {
+				translateRefsToHost(ctx, v, vNamespace)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					translateRefsToHost(ctx, m, vNamespace)
+				}
+			}
+		}
+	}
+}
+
+// SecretNamesFromMonitor returns the namespace/name of the secrets referenced by the endpoints of a service or pod monitor
+func SecretNamesFromMonitor(monitor *unstructured.Unstructured) []string {
+	secrets := []string{}
+	for _, endpointsField := range []string{"endpoints", "podMetricsEndpoints"} {
+		for _, endpoint := range generic.NestedMaps(monitor.Object, "spec", endpointsField) {
+			secrets = append(secrets, secretNamesFromRefs(endpoint, monitor.GetNamespace())...)
+		}
+	}
+
+	return translate.UniqueSlice(secrets)
+}
+
+func secretNamesFromRefs(obj map[string]interface{}, namespace string) []string {
+	secrets := []string{}
+	for key, value := range obj {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if name, ok := v["name"].(string); ok && name != "" && secretRefFields[key] {
+				secrets = append(secrets, namespace+"/"+name)
+			} else if !ok {
+				secrets = append(secrets, secretNamesFromRefs(v, namespace)...)
+			}
+		case []interface{}:
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					secrets = append(secrets, secretNamesFromRefs(m, namespace)...)
+				}
+			}
+		}
+	}
+
+	return secrets
+}
+
+func sanitizeLabelName(name string) string {
+	return invalidLabelNameChars.ReplaceAllString(name, "_")
+}
@@ -3,14 +3,20 @@ package resources
 import (
 	"fmt"
 
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/certmanager"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/configmaps"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/csidrivers"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/csinodes"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/csistoragecapacities"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/endpoints"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/endpointslices"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/events"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/externalsecrets"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/gatewayapi"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingressclasses"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/istio"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/keda"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/namespaces"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/networkpolicies"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/nodes"
@@ -19,6 +25,10 @@ import (
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/poddisruptionbudgets"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/pods"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/priorityclasses"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/prometheusoperator"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/resourceclaims"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/resourceclasses"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/runtimeclasses"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/secrets"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/serviceaccounts"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/services"
@@ -45,14 +55,41 @@ func getSyncers(ctx *synccontext.RegisterContext) []BuildController {
 		isEnabled(ctx.Config.Sync.ToHost.ConfigMaps.Enabled, configmaps.New),
 		isEnabled(ctx.Config.Sync.ToHost.Secrets.Enabled, secrets.New),
 		isEnabled(ctx.Config.Sync.ToHost.Endpoints.Enabled, endpoints.New),
+		isEnabled(ctx.Config.Sync.ToHost.EndpointSlices.Enabled, endpointslices.New),
 		isEnabled(ctx.Config.Sync.ToHost.Pods.Enabled, pods.New),
 		isEnabled(ctx.Config.Sync.FromHost.Events.Enabled, events.New),
 		isEnabled(ctx.Config.Sync.ToHost.PersistentVolumeClaims.Enabled, persistentvolumeclaims.New),
 		isEnabled(ctx.Config.Sync.ToHost.Ingresses.Enabled, ingresses.New),
 		isEnabled(ctx.Config.Sync.FromHost.IngressClasses.Enabled, ingressclasses.New),
+		isEnabled(ctx.Config.Sync.FromHost.RuntimeClasses.Enabled, runtimeclasses.New),
+		isEnabled(ctx.Config.Sync.FromHost.GatewayClasses.Enabled, gatewayapi.NewGatewayClasses),
+		isEnabled(ctx.Config.Sync.ToHost.Gateways.Enabled, gatewayapi.NewGateways),
+		isEnabled(ctx.Config.Sync.ToHost.HTTPRoutes.Enabled, gatewayapi.NewHTTPRoutes),
+		isEnabled(ctx.Config.Sync.ToHost.GRPCRoutes.Enabled, gatewayapi.NewGRPCRoutes),
+		isEnabled(ctx.Config.Sync.ToHost.ReferenceGrants.Enabled, gatewayapi.NewReferenceGrants),
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.ServiceMonitors.Enabled, prometheusoperator.NewServiceMonitors),
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.PodMonitors.Enabled, prometheusoperator.NewPodMonitors),
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.PrometheusRules.Enabled, prometheusoperator.NewPrometheusRules),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.ToHost.Certificates.Enabled, certmanager.NewCertificates),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.ToHost.Issuers.Enabled, certmanager.NewIssuers),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.FromHost.ClusterIssuers.Enabled, certmanager.NewClusterIssuers),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.VirtualServices.Enabled, istio.NewVirtualServices),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.DestinationRules.Enabled, istio.NewDestinationRules),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.Gateways.Enabled, istio.NewGateways),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.PeerAuthentications.Enabled, istio.NewPeerAuthentications),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.AuthorizationPolicies.Enabled, istio.NewAuthorizationPolicies),
+		isEnabled(ctx.Config.Integrations.ExternalSecrets.Enabled && ctx.Config.Integrations.ExternalSecrets.Sync.ExternalSecrets.Enabled, externalsecrets.NewExternalSecrets),
+		isEnabled(ctx.Config.Integrations.ExternalSecrets.Enabled && ctx.Config.Integrations.ExternalSecrets.Sync.Stores.Enabled, externalsecrets.NewSecretStores),
+		isEnabled(ctx.Config.Integrations.KEDA.Enabled && ctx.Config.Integrations.KEDA.Sync.ScaledObjects.Enabled, keda.NewScaledObjects),
+		isEnabled(ctx.Config.Integrations.KEDA.Enabled && ctx.Config.Integrations.KEDA.Sync.TriggerAuthentications.Enabled, keda.NewTriggerAuthentications),
 		isEnabled(ctx.Config.Sync.ToHost.StorageClasses.Enabled, storageclasses.New),
 		isEnabled(ctx.Config.Sync.FromHost.StorageClasses.Enabled == "true", storageclasses.NewHostStorageClassSyncer),
 		isEnabled(ctx.Config.Sync.ToHost.PriorityClasses.Enabled, priorityclasses.New),
+		isEnabled(ctx.Config.Sync.FromHost.PriorityClasses.Enabled, priorityclasses.NewHostPriorityClassSyncer),
+		isEnabled(ctx.Config.Sync.ToHost.ResourceClaims.Enabled, resourceclaims.New),
+		isEnabled(ctx.Config.Sync.ToHost.ResourceClaimTemplates.Enabled, resourceclaims.NewResourceClaimTemplates),
+		isEnabled(ctx.Config.Sync.FromHost.ResourceClasses.Enabled, resourceclasses.New),
+		isEnabled(ctx.Config.Sync.FromHost.ResourceSlices.Enabled, resourceclasses.NewResourceSlices),
 		isEnabled(ctx.Config.Sync.ToHost.PodDisruptionBudgets.Enabled, poddisruptionbudgets.New),
 		isEnabled(ctx.Config.Sync.ToHost.NetworkPolicies.Enabled, networkpolicies.New),
 		isEnabled(ctx.Config.Sync.ToHost.VolumeSnapshots.Enabled, volumesnapshotclasses.New),
@@ -0,0 +1,157 @@
+package resourceclaims
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableResourceClaims := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.ToHost.ResourceClaims.Enabled = true
+		vConfig.Sync.ToHost.ResourceClaimTemplates.Enabled = true
+	}
+
+	hostMeta := func(name string, gvk schema.GroupVersionKind) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      translate.Default.HostName(name, "test"),
+			Namespace: syncertesting.DefaultTestTargetNamespace,
+			Annotations: map[string]string{
+				translate.NameAnnotation:      name,
+				translate.NamespaceAnnotation: "test",
+				translate.UIDAnnotation:       "",
+				translate.KindAnnotation:      gvk.String(),
+			},
+			Labels: map[string]string{
+				translate.MarkerLabel:    translate.VClusterName,
+				translate.NamespaceLabel: "test",
+			},
+		}
+	}
+
+	vSpec := resourcev1alpha2.ResourceClaimSpec{
+		ResourceClassName: "gpu.example.com",
+		ParametersRef: &resourcev1alpha2.ResourceClaimParametersReference{
+			Kind: "ConfigMap",
+			Name: "gpu-parameters",
+		},
+		AllocationMode: resourcev1alpha2.AllocationModeWaitForFirstConsumer,
+	}
+	pSpec := *vSpec.DeepCopy()
+	pSpec.ParametersRef.Name = translate.Default.HostName("gpu-parameters", "test")
+
+	vClaim := &resourcev1alpha2.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu",
+			Namespace: "test",
+		},
+		Spec: vSpec,
+	}
+	pClaim := &resourcev1alpha2.ResourceClaim{
+		ObjectMeta: hostMeta("gpu", mappings.ResourceClaims()),
+		Spec:       pSpec,
+	}
+
+	vPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workload",
+			Namespace: "test",
+			UID:       "virtual-uid",
+		},
+	}
+	pClaimAllocated := pClaim.DeepCopy()
+	pClaimAllocated.Status = resourcev1alpha2.ResourceClaimStatus{
+		DriverName: "gpu.example.com",
+		Allocation: &resourcev1alpha2.AllocationResult{
+			Shareable: true,
+		},
+		ReservedFor: []resourcev1alpha2.ResourceClaimConsumerReference{
+			{Resource: "pods", Name: translate.Default.HostName("workload", "test"), UID: "host-uid"},
+			{Resource: "pods", Name: "other", UID: "other-uid"},
+		},
+	}
+	vClaimAllocated := vClaim.DeepCopy()
+	vClaimAllocated.Status = resourcev1alpha2.ResourceClaimStatus{
+		DriverName: "gpu.example.com",
+		Allocation: &resourcev1alpha2.AllocationResult{
+			Shareable: true,
+		},
+		ReservedFor: []resourcev1alpha2.ResourceClaimConsumerReference{
+			{Resource: "pods", Name: "workload", UID: "virtual-uid"},
+		},
+	}
+
+	vTemplate := &resourcev1alpha2.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu-template",
+			Namespace: "test",
+		},
+		Spec: resourcev1alpha2.ResourceClaimTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "workload"}},
+			Spec:       vSpec,
+		},
+	}
+	pTemplate := &resourcev1alpha2.ResourceClaimTemplate{
+		ObjectMeta: hostMeta("gpu-template", mappings.ResourceClaimTemplates()),
+		Spec: resourcev1alpha2.ResourceClaimTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "workload"}},
+			Spec:       pSpec,
+		},
+	}
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                "Create resource claim",
+			AdjustConfig:        enableResourceClaims,
+			InitialVirtualState: []runtime.Object{vClaim.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClaims(): {pClaim.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*resourceClaimSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClaim.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync allocation back",
+			AdjustConfig:         enableResourceClaims,
+			InitialVirtualState:  []runtime.Object{vClaim.DeepCopy(), vPod.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pClaimAllocated.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClaims(): {vClaimAllocated.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClaims(): {pClaimAllocated.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*resourceClaimSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pClaimAllocated.DeepCopy(), vClaim.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create resource claim template",
+			AdjustConfig:        enableResourceClaims,
+			InitialVirtualState: []runtime.Object{vTemplate.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClaimTemplates(): {pTemplate.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewResourceClaimTemplates)
+				_, err := syncer.(*resourceClaimTemplateSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vTemplate.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
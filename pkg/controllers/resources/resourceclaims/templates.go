@@ -0,0 +1,74 @@
+package resourceclaims
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewResourceClaimTemplates creates a syncer for resource claim templates. The claims generated from the
+// templates for a pod are created by the host controller manager and only exist within the host cluster.
+func NewResourceClaimTemplates(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.ResourceClaimTemplates())
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceClaimTemplateSyncer{
+		GenericTranslator: translator.NewGenericTranslator(ctx, "resourceclaimtemplate", &resourcev1alpha2.ResourceClaimTemplate{}, mapper),
+	}, nil
+}
+
+type resourceClaimTemplateSyncer struct {
+	syncertypes.GenericTranslator
+}
+
+var _ syncertypes.Syncer = &resourceClaimTemplateSyncer{}
+
+func (s *resourceClaimTemplateSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*resourcev1alpha2.ResourceClaimTemplate](s)
+}
+
+func (s *resourceClaimTemplateSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*resourcev1alpha2.ResourceClaimTemplate]) (ctrl.Result, error) {
+	if event.IsDelete() {
+		return syncer.DeleteVirtualObject(ctx, event.Virtual, "host object was deleted")
+	}
+
+	pObj := translate.HostMetadata(ctx, event.Virtual, s.VirtualToHost(ctx, types.NamespacedName{Name: event.Virtual.Name, Namespace: event.Virtual.Namespace}, event.Virtual))
+	pObj.Spec.Spec = *translateSpec(ctx, &event.Virtual.Spec.Spec, event.Virtual.Namespace)
+	return syncer.CreateHostObject(ctx, event.Virtual, pObj)
+}
+
+func (s *resourceClaimTemplateSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*resourcev1alpha2.ResourceClaimTemplate]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.Spec.ObjectMeta.DeepCopyInto(&event.Host.Spec.ObjectMeta)
+	event.Host.Spec.Spec = *translateSpec(ctx, &event.Virtual.Spec.Spec, event.Virtual.Namespace)
+	event.Host.Annotations = translate.HostAnnotations(event.Virtual, event.Host)
+	event.Host.Labels = translate.HostLabels(ctx, event.Virtual, event.Host)
+	return ctrl.Result{}, nil
+}
+
+func (s *resourceClaimTemplateSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*resourcev1alpha2.ResourceClaimTemplate]) (_ ctrl.Result, retErr error) {
+	// virtual object is not here anymore, so we delete
+	return syncer.DeleteHostObject(ctx, event.Host, "virtual object was deleted")
+}
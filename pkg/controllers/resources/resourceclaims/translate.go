@@ -0,0 +1,80 @@
+package resourceclaims
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	corev1 "k8s.io/api/core/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func (s *resourceClaimSyncer) translate(ctx *synccontext.SyncContext, vResourceClaim *resourcev1alpha2.ResourceClaim) *resourcev1alpha2.ResourceClaim {
+	pResourceClaim := translate.HostMetadata(ctx, vResourceClaim, s.VirtualToHost(ctx, types.NamespacedName{Name: vResourceClaim.Name, Namespace: vResourceClaim.Namespace}, vResourceClaim))
+	pResourceClaim.Spec = *translateSpec(ctx, &vResourceClaim.Spec, vResourceClaim.Namespace)
+	pResourceClaim.Status = resourcev1alpha2.ResourceClaimStatus{}
+	return pResourceClaim
+}
+
+func (s *resourceClaimSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *resourcev1alpha2.ResourceClaim) error {
+	// spec is owned by the virtual object
+	pObj.Spec = *translateSpec(ctx, &vObj.Spec, vObj.Namespace)
+
+	// status is owned by the host object, which gets allocated by the host scheduler and drivers
+	status, err := translateStatus(ctx, &pObj.Status, pObj.Namespace)
+	if err != nil {
+		return err
+	}
+	vObj.Status = *status
+
+	pObj.Annotations = translate.HostAnnotations(vObj, pObj)
+	pObj.Labels = translate.HostLabels(ctx, vObj, pObj)
+	return nil
+}
+
+// translateSpec translates the parameters reference of a resource claim spec to the host. The resource class
+// is cluster scoped and synced from the host, so its name stays the same.
+func translateSpec(ctx *synccontext.SyncContext, spec *resourcev1alpha2.ResourceClaimSpec, vNamespace string) *resourcev1alpha2.ResourceClaimSpec {
+	outSpec := spec.DeepCopy()
+	if outSpec.ParametersRef != nil && outSpec.ParametersRef.APIGroup == "" && outSpec.ParametersRef.Kind == "ConfigMap" && ctx.Mappings.Has(mappings.ConfigMaps()) {
+		outSpec.ParametersRef.Name = mappings.VirtualToHostName(ctx, outSpec.ParametersRef.Name, vNamespace, mappings.ConfigMaps())
+	}
+
+	return outSpec
+}
+
+// translateStatus translates the pods a host resource claim is reserved for back to the virtual pods. Consumers
+// that don't belong to a virtual pod are omitted.
+func translateStatus(ctx *synccontext.SyncContext, status *resourcev1alpha2.ResourceClaimStatus, pNamespace string) (*resourcev1alpha2.ResourceClaimStatus, error) {
+	outStatus := status.DeepCopy()
+	outStatus.ReservedFor = nil
+	for _, consumer := range status.ReservedFor {
+		if consumer.APIGroup != "" || consumer.Resource != "pods" {
+			continue
+		}
+
+		vName := mappings.HostToVirtual(ctx, consumer.Name, pNamespace, nil, mappings.Pods())
+		if vName.Name == "" {
+			continue
+		}
+
+		vPod := &corev1.Pod{}
+		err := ctx.VirtualClient.Get(ctx, vName, vPod)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		outStatus.ReservedFor = append(outStatus.ReservedFor, resourcev1alpha2.ResourceClaimConsumerReference{
+			Resource: consumer.Resource,
+			Name:     vPod.Name,
+			UID:      vPod.UID,
+		})
+	}
+
+	return outStatus, nil
+}
@@ -0,0 +1,77 @@
+package resourceclasses
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewResourceSlices creates a syncer that mirrors the resource slices published by the host drivers
+func NewResourceSlices(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.ResourceSlices())
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceSliceSyncer{
+		Mapper: mapper,
+	}, nil
+}
+
+type resourceSliceSyncer struct {
+	synccontext.Mapper
+}
+
+func (s *resourceSliceSyncer) Name() string {
+	return "resourceslice"
+}
+
+func (s *resourceSliceSyncer) Resource() client.Object {
+	return &resourcev1alpha2.ResourceSlice{}
+}
+
+var _ syncertypes.Syncer = &resourceSliceSyncer{}
+
+func (s *resourceSliceSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*resourcev1alpha2.ResourceSlice](s)
+}
+
+func (s *resourceSliceSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*resourcev1alpha2.ResourceSlice]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, types.NamespacedName{Name: event.Host.Name}, false)
+	ctx.Log.Infof("create ResourceSlice %s, because it does not exist in virtual cluster", vObj.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (s *resourceSliceSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*resourcev1alpha2.ResourceSlice]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.Annotations = event.Host.Annotations
+	event.Virtual.Labels = event.Host.Labels
+	event.Virtual.NodeName = event.Host.NodeName
+	event.Virtual.DriverName = event.Host.DriverName
+	event.Host.ResourceModel.DeepCopyInto(&event.Virtual.ResourceModel)
+	return ctrl.Result{}, nil
+}
+
+func (s *resourceSliceSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*resourcev1alpha2.ResourceSlice]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual ResourceSlice %s, because host object is missing", event.Virtual.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
@@ -0,0 +1,77 @@
+package resourceclasses
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.ResourceClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceClassSyncer{
+		Mapper: mapper,
+	}, nil
+}
+
+type resourceClassSyncer struct {
+	synccontext.Mapper
+}
+
+func (s *resourceClassSyncer) Name() string {
+	return "resourceclass"
+}
+
+func (s *resourceClassSyncer) Resource() client.Object {
+	return &resourcev1alpha2.ResourceClass{}
+}
+
+var _ syncertypes.Syncer = &resourceClassSyncer{}
+
+func (s *resourceClassSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*resourcev1alpha2.ResourceClass](s)
+}
+
+func (s *resourceClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*resourcev1alpha2.ResourceClass]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, types.NamespacedName{Name: event.Host.Name}, false)
+	ctx.Log.Infof("create ResourceClass %s, because it does not exist in virtual cluster", vObj.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (s *resourceClassSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*resourcev1alpha2.ResourceClass]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.Annotations = event.Host.Annotations
+	event.Virtual.Labels = event.Host.Labels
+	event.Virtual.DriverName = event.Host.DriverName
+	event.Virtual.ParametersRef = event.Host.ParametersRef.DeepCopy()
+	event.Virtual.SuitableNodes = event.Host.SuitableNodes.DeepCopy()
+	event.Virtual.StructuredParameters = event.Host.StructuredParameters
+	return ctrl.Result{}, nil
+}
+
+func (s *resourceClassSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*resourcev1alpha2.ResourceClass]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual ResourceClass %s, because host object is missing", event.Virtual.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
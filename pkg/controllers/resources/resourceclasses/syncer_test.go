@@ -0,0 +1,104 @@
+package resourceclasses
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"gotest.tools/assert"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+func TestSync(t *testing.T) {
+	enableResourceClasses := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.FromHost.ResourceClasses.Enabled = true
+		vConfig.Sync.FromHost.ResourceSlices.Enabled = true
+	}
+
+	pClass := &resourcev1alpha2.ResourceClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gpu.example.com",
+			Labels: map[string]string{"driver": "fake"},
+		},
+		DriverName:           "gpu.example.com",
+		StructuredParameters: ptr.To(true),
+	}
+	vClass := pClass.DeepCopy()
+	pClassUpdated := pClass.DeepCopy()
+	pClassUpdated.StructuredParameters = ptr.To(false)
+
+	pSlice := &resourcev1alpha2.ResourceSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1-gpu.example.com",
+		},
+		NodeName:   "node-1",
+		DriverName: "gpu.example.com",
+		ResourceModel: resourcev1alpha2.ResourceModel{
+			NamedResources: &resourcev1alpha2.NamedResourcesResources{
+				Instances: []resourcev1alpha2.NamedResourcesInstance{{Name: "gpu-0"}, {Name: "gpu-1"}},
+			},
+		},
+	}
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                 "Mirror resource class",
+			AdjustConfig:         enableResourceClasses,
+			InitialPhysicalState: []runtime.Object{pClass.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClasses(): {vClass.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*resourceClassSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pClass.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update resource class",
+			AdjustConfig:         enableResourceClasses,
+			InitialVirtualState:  []runtime.Object{vClass.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pClassUpdated.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClasses(): {pClassUpdated.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*resourceClassSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pClassUpdated.DeepCopy(), vClass.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Delete resource class",
+			AdjustConfig:        enableResourceClasses,
+			InitialVirtualState: []runtime.Object{vClass.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceClasses(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*resourceClassSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vClass.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Mirror resource slice",
+			AdjustConfig:         enableResourceClasses,
+			InitialPhysicalState: []runtime.Object{pSlice.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.ResourceSlices(): {pSlice.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, NewResourceSlices)
+				_, err := syncer.(*resourceSliceSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pSlice.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package runtimeclasses
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func New(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	mapper, err := ctx.Mappings.ByGVK(mappings.RuntimeClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimeClassSyncer{
+		Mapper: mapper,
+
+		allowed: ctx.Config.Sync.FromHost.RuntimeClasses.Allowed,
+	}, nil
+}
+
+type runtimeClassSyncer struct {
+	synccontext.Mapper
+
+	allowed []string
+}
+
+func (r *runtimeClassSyncer) Name() string {
+	return "runtimeclass"
+}
+
+func (r *runtimeClassSyncer) Resource() client.Object {
+	return &nodev1.RuntimeClass{}
+}
+
+var _ syncertypes.ObjectExcluder = &runtimeClassSyncer{}
+
+func (r *runtimeClassSyncer) ExcludeVirtual(_ client.Object) bool {
+	return false
+}
+
+func (r *runtimeClassSyncer) ExcludePhysical(pObj client.Object) bool {
+	return !IsAllowed(r.allowed, pObj.GetName())
+}
+
+var _ syncertypes.Syncer = &runtimeClassSyncer{}
+
+func (r *runtimeClassSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return syncer.ToGenericSyncer[*nodev1.RuntimeClass](r)
+}
+
+func (r *runtimeClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, event *synccontext.SyncToVirtualEvent[*nodev1.RuntimeClass]) (ctrl.Result, error) {
+	vObj := translate.CopyObjectWithName(event.Host, types.NamespacedName{Name: event.Host.Name}, false)
+	ctx.Log.Infof("create runtime class %s, because it does not exist in virtual cluster", vObj.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx, vObj)
+}
+
+func (r *runtimeClassSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*nodev1.RuntimeClass]) (_ ctrl.Result, retErr error) {
+	patch, err := patcher.NewSyncerPatcher(ctx, event.Host, event.Virtual)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	event.Virtual.Annotations = event.Host.Annotations
+	event.Virtual.Labels = event.Host.Labels
+	event.Virtual.Overhead = event.Host.Overhead.DeepCopy()
+	event.Virtual.Scheduling = event.Host.Scheduling.DeepCopy()
+	return ctrl.Result{}, nil
+}
+
+func (r *runtimeClassSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext.SyncToHostEvent[*nodev1.RuntimeClass]) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual runtime class %s, because physical object is missing or not allowed", event.Virtual.Name)
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx, event.Virtual)
+}
+
+// IsAllowed returns if the runtime class with the given name may be used within the virtual cluster
+func IsAllowed(allowed []string, name string) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, name)
+}
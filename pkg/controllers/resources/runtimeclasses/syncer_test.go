@@ -0,0 +1,92 @@
+package runtimeclasses
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	enableRuntimeClasses := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.FromHost.RuntimeClasses.Enabled = true
+	}
+
+	pObj := &nodev1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gvisor",
+			Labels: map[string]string{"sandbox": "true"},
+		},
+		Handler: "runsc",
+		Scheduling: &nodev1.Scheduling{
+			NodeSelector: map[string]string{"sandbox.gke.io/runtime": "gvisor"},
+		},
+	}
+	vObj := pObj.DeepCopy()
+
+	pObjUpdated := pObj.DeepCopy()
+	pObjUpdated.Overhead = &nodev1.Overhead{
+		PodFixed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+	}
+
+	syncertesting.RunTests(t, []*syncertesting.SyncTest{
+		{
+			Name:                 "Import",
+			AdjustConfig:         enableRuntimeClasses,
+			InitialPhysicalState: []runtime.Object{pObj.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.RuntimeClasses(): {vObj.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*runtimeClassSyncer).SyncToVirtual(syncCtx, synccontext.NewSyncToVirtualEvent(pObj.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update",
+			AdjustConfig:         enableRuntimeClasses,
+			InitialVirtualState:  []runtime.Object{vObj.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pObjUpdated.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.RuntimeClasses(): {pObjUpdated.DeepCopy()},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*runtimeClassSyncer).Sync(syncCtx, synccontext.NewSyncEvent(pObjUpdated.DeepCopy(), vObj.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Delete",
+			AdjustConfig:        enableRuntimeClasses,
+			InitialVirtualState: []runtime.Object{vObj.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				mappings.RuntimeClasses(): {},
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := syncertesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*runtimeClassSyncer).SyncToHost(syncCtx, synccontext.NewSyncToHostEvent(vObj.DeepCopy()))
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestExcludePhysical(t *testing.T) {
+	allowAll := &runtimeClassSyncer{}
+	assert.Equal(t, allowAll.ExcludePhysical(&nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: "gvisor"}}), false)
+
+	allowKata := &runtimeClassSyncer{allowed: []string{"kata"}}
+	assert.Equal(t, allowKata.ExcludePhysical(&nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: "gvisor"}}), true)
+	assert.Equal(t, allowKata.ExcludePhysical(&nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: "kata"}}), false)
+}
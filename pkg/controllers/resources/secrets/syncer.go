@@ -17,13 +17,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/certmanager"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/externalsecrets"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/gatewayapi"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/istio"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/keda"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/pods"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/prometheusoperator"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +52,8 @@ func NewSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 		GenericTranslator: translator.NewGenericTranslator(ctx, "secret", &corev1.Secret{}, mapper),
 
 		includeIngresses: ctx.Config.Sync.ToHost.Ingresses.Enabled,
+		includeGateways:  ctx.Config.Sync.ToHost.Gateways.Enabled,
+		referencingKinds: referencingKinds(ctx),
 
 		syncAllSecrets: ctx.Config.Sync.ToHost.Secrets.All,
 	}, nil
@@ -53,10 +63,56 @@ type secretSyncer struct {
 	syncertypes.GenericTranslator
 
 	includeIngresses bool
+	includeGateways  bool
+	referencingKinds []referencingKind
 
 	syncAllSecrets bool
 }
 
+// referencingKind is a synced custom resource kind that may reference secrets
+type referencingKind struct {
+	gvk         schema.GroupVersionKind
+	index       string
+	secretNames func(obj *unstructured.Unstructured) []string
+}
+
+// referencingKinds returns the synced integration kinds that may reference secrets
+func referencingKinds(ctx *synccontext.RegisterContext) []referencingKind {
+	kinds := []referencingKind{}
+	prometheusOperator := ctx.Config.Integrations.PrometheusOperator
+	if prometheusOperator.Enabled && prometheusOperator.Sync.ServiceMonitors.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.ServiceMonitors(), index: constants.IndexByMonitorSecret, secretNames: prometheusoperator.SecretNamesFromMonitor})
+	}
+	if prometheusOperator.Enabled && prometheusOperator.Sync.PodMonitors.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.PodMonitors(), index: constants.IndexByMonitorSecret, secretNames: prometheusoperator.SecretNamesFromMonitor})
+	}
+
+	certManager := ctx.Config.Integrations.CertManager
+	if certManager.Enabled && certManager.Sync.ToHost.Certificates.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.Certificates(), index: constants.IndexByCertificateSecret, secretNames: certmanager.SecretNamesFromCertificate})
+	}
+	if certManager.Enabled && certManager.Sync.ToHost.Issuers.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.Issuers(), index: constants.IndexByIssuerSecret, secretNames: certmanager.SecretNamesFromIssuer})
+	}
+
+	istioConfig := ctx.Config.Integrations.Istio
+	if istioConfig.Enabled && istioConfig.Sync.Gateways.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.IstioGateways(), index: constants.IndexByIstioGatewaySecret, secretNames: istio.SecretNamesFromGateway})
+	}
+
+	externalSecretsConfig := ctx.Config.Integrations.ExternalSecrets
+	if externalSecretsConfig.Enabled && externalSecretsConfig.Sync.Stores.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.SecretStores(), index: constants.IndexBySecretStoreSecret, secretNames: externalsecrets.SecretNamesFromSecretStore})
+	}
+
+	kedaConfig := ctx.Config.Integrations.KEDA
+	if kedaConfig.Enabled && kedaConfig.Sync.TriggerAuthentications.Enabled {
+		kinds = append(kinds, referencingKind{gvk: mappings.TriggerAuthentications(), index: constants.IndexByTriggerAuthenticationSecret, secretNames: keda.SecretNamesFromTriggerAuthentication})
+	}
+
+	return kinds
+}
+
 var _ syncertypes.Syncer = &secretSyncer{}
 
 func (s *secretSyncer) Syncer() syncertypes.Sync[client.Object] {
@@ -75,6 +131,24 @@ func (s *secretSyncer) RegisterIndices(ctx *synccontext.RegisterContext) error {
 		}
 	}
 
+	if ctx.Config.Sync.ToHost.Gateways.Enabled {
+		err := ctx.VirtualManager.GetFieldIndexer().IndexField(ctx, newGateway(), constants.IndexByGatewaySecret, func(rawObj client.Object) []string {
+			return gatewayapi.SecretNamesFromGateway(rawObj.(*unstructured.Unstructured))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, kind := range s.referencingKinds {
+		err := ctx.VirtualManager.GetFieldIndexer().IndexField(ctx, newUnstructured(kind.gvk), kind.index, func(rawObj client.Object) []string {
+			return kind.secretNames(rawObj.(*unstructured.Unstructured))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	err := ctx.VirtualManager.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, constants.IndexByPodSecret, func(rawObj client.Object) []string {
 		return pods.SecretNamesFromPod(ctx.ToSyncContext("secret-indexer"), rawObj.(*corev1.Pod))
 	})
@@ -94,6 +168,18 @@ func (s *secretSyncer) ModifyController(registerCtx *synccontext.RegisterContext
 		}))
 	}
 
+	if s.includeGateways {
+		builder = builder.Watches(newGateway(), handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			return mapGateways(object)
+		}))
+	}
+
+	for _, kind := range s.referencingKinds {
+		builder = builder.Watches(newUnstructured(kind.gvk), handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			return mapReferencingObject(object, kind.secretNames)
+		}))
+	}
+
 	return builder.Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
 		return mapPods(registerCtx.ToSyncContext("secret-syncer"), object)
 	})), nil
@@ -118,7 +204,7 @@ func (s *secretSyncer) SyncToHost(ctx *synccontext.SyncContext, event *syncconte
 		newSecret.Type = corev1.SecretTypeOpaque
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, newSecret, s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, newSecret)
 }
 
 func (s *secretSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*corev1.Secret]) (_ ctrl.Result, retErr error) {
@@ -145,10 +231,6 @@ func (s *secretSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.Syn
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
-
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
 	// check data
@@ -200,6 +282,36 @@ func (s *secretSyncer) isSecretUsed(ctx *synccontext.SyncContext, vObj runtime.O
 		}
 	}
 
+	// check if we also sync gateways
+	if s.includeGateways {
+		gatewayList := &unstructured.UnstructuredList{}
+		gatewayList.SetGroupVersionKind(mappings.Gateways().GroupVersion().WithKind("GatewayList"))
+		err := ctx.VirtualClient.List(ctx, gatewayList, client.MatchingFields{constants.IndexByGatewaySecret: secret.Namespace + "/" + secret.Name})
+		if err != nil {
+			return false, err
+		}
+
+		isUsed = meta.LenList(gatewayList) > 0
+		if isUsed {
+			return true, nil
+		}
+	}
+
+	// check if we also sync integration objects that reference the secret
+	for _, kind := range s.referencingKinds {
+		objList := &unstructured.UnstructuredList{}
+		objList.SetGroupVersionKind(kind.gvk.GroupVersion().WithKind(kind.gvk.Kind + "List"))
+		err := ctx.VirtualClient.List(ctx, objList, client.MatchingFields{kind.index: secret.Namespace + "/" + secret.Name})
+		if err != nil {
+			return false, err
+		}
+
+		isUsed = meta.LenList(objList) > 0
+		if isUsed {
+			return true, nil
+		}
+	}
+
 	if s.syncAllSecrets {
 		return true, nil
 	}
@@ -230,6 +342,64 @@ func mapIngresses(ctx *synccontext.SyncContext, obj client.Object) []reconcile.R
 	return requests
 }
 
+func mapGateways(obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	names := gatewayapi.SecretNamesFromGateway(gateway)
+	for _, name := range names {
+		splitted := strings.Split(name, "/")
+		if len(splitted) == 2 {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: splitted[0],
+					Name:      splitted[1],
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+func newGateway() *unstructured.Unstructured {
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(mappings.Gateways())
+	return gateway
+}
+
+func mapReferencingObject(obj client.Object, secretNames func(obj *unstructured.Unstructured) []string) []reconcile.Request {
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	names := secretNames(unstructuredObj)
+	for _, name := range names {
+		splitted := strings.Split(name, "/")
+		if len(splitted) == 2 {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: splitted[0],
+					Name:      splitted[1],
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+func newUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
 func mapPods(ctx *synccontext.SyncContext, obj client.Object) []reconcile.Request {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
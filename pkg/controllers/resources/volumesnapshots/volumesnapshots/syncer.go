@@ -60,10 +60,10 @@ func (s *volumeSnapshotSyncer) SyncToHost(ctx *synccontext.SyncContext, event *s
 
 	pObj, err := s.translate(ctx, event.Virtual)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, translator2.NewTranslateError(err)
 	}
 
-	return syncer.CreateHostObject(ctx, event.Virtual, pObj, s.EventRecorder())
+	return syncer.CreateHostObject(ctx, event.Virtual, pObj)
 }
 
 func (s *volumeSnapshotSyncer) Sync(ctx *synccontext.SyncContext, event *synccontext.SyncEvent[*volumesnapshotv1.VolumeSnapshot]) (_ ctrl.Result, retErr error) {
@@ -128,10 +128,6 @@ func (s *volumeSnapshotSyncer) Sync(ctx *synccontext.SyncContext, event *synccon
 		if err := patch.Patch(ctx, event.Host, event.Virtual); err != nil {
 			retErr = utilerrors.NewAggregate([]error{retErr, err})
 		}
-
-		if retErr != nil {
-			s.EventRecorder().Eventf(event.Virtual, "Warning", "SyncError", "Error syncing: %v", retErr)
-		}
 	}()
 
 	// check backwards update
@@ -0,0 +1,338 @@
+package metricsserver
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/loft-sh/vcluster/pkg/apiservice"
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/server/filters"
+	"github.com/loft-sh/vcluster/pkg/server/handler"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	customMetricsHostPort   = 9002
+	externalMetricsHostPort = 9003
+
+	customMetricsServiceName   = "vcluster-custom-metrics"
+	externalMetricsServiceName = "vcluster-external-metrics"
+
+	// NamespaceMetricsResource is the resource of metrics that describe a namespace itself
+	NamespaceMetricsResource = "metrics"
+
+	// ScaledObjectLabel is used by KEDA to select the external metrics of a scaled object
+	ScaledObjectLabel = "scaledobject.keda.sh/name"
+)
+
+var (
+	CustomMetricsGroupVersions = []schema.GroupVersion{
+		{Group: "custom.metrics.k8s.io", Version: "v1beta1"},
+		{Group: "custom.metrics.k8s.io", Version: "v1beta2"},
+	}
+
+	ExternalMetricsGroupVersion = schema.GroupVersion{
+		Group:   "external.metrics.k8s.io",
+		Version: "v1beta1",
+	}
+)
+
+// registerMetricsAPIs starts the discovery proxies for the custom and external metrics apis of the host cluster
+func registerMetricsAPIs(ctx *synccontext.ControllerContext) error {
+	customMetrics := ctx.Config.Integrations.MetricsServer.CustomMetrics
+	if customMetrics.Enabled {
+		err := apiservice.StartAPIServiceProxy(
+			ctx,
+			cmp.Or(customMetrics.APIService.Service.Name, "prometheus-adapter"),
+			cmp.Or(customMetrics.APIService.Service.Namespace, "monitoring"),
+			cmp.Or(customMetrics.APIService.Service.Port, 443),
+			customMetricsHostPort,
+		)
+		if err != nil {
+			return fmt.Errorf("start custom metrics api service proxy: %w", err)
+		}
+	}
+
+	externalMetrics := ctx.Config.Integrations.MetricsServer.ExternalMetrics
+	if externalMetrics.Enabled {
+		err := apiservice.StartAPIServiceProxy(
+			ctx,
+			cmp.Or(externalMetrics.APIService.Service.Name, "keda-operator-metrics-apiserver"),
+			cmp.Or(externalMetrics.APIService.Service.Namespace, "keda"),
+			cmp.Or(externalMetrics.APIService.Service.Port, 443),
+			externalMetricsHostPort,
+		)
+		if err != nil {
+			return fmt.Errorf("start external metrics api service proxy: %w", err)
+		}
+	}
+
+	if customMetrics.Enabled || externalMetrics.Enabled {
+		ctx.PostServerHooks = append(ctx.PostServerHooks, func(h http.Handler, ctx *synccontext.ControllerContext) http.Handler {
+			return WithMetricsAPIProxy(h, ctx.ToRegisterContext())
+		})
+	}
+
+	return nil
+}
+
+func registerOrDeregisterMetricsAPIs(ctx *synccontext.ControllerContext) error {
+	for _, groupVersion := range CustomMetricsGroupVersions {
+		var err error
+		if ctx.Config.Integrations.MetricsServer.CustomMetrics.Enabled {
+			err = apiservice.RegisterAPIService(ctx, customMetricsServiceName, customMetricsHostPort, groupVersion)
+		} else {
+			err = apiservice.DeregisterAPIService(ctx, customMetricsServiceName, groupVersion)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.Config.Integrations.MetricsServer.ExternalMetrics.Enabled {
+		return apiservice.RegisterAPIService(ctx, externalMetricsServiceName, externalMetricsHostPort, ExternalMetricsGroupVersion)
+	}
+
+	return apiservice.DeregisterAPIService(ctx, externalMetricsServiceName, ExternalMetricsGroupVersion)
+}
+
+// WithMetricsAPIProxy forwards custom and external metrics requests to the adapters in the host cluster
+func WithMetricsAPIProxy(h http.Handler, registerCtx *synccontext.RegisterContext) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, fmt.Errorf("request info is missing"))
+			return
+		}
+
+		if isCustomMetricsRequest(registerCtx, info) {
+			handleMetricsAPIRequest(registerCtx, w, req, info, translateCustomMetricsRequest, rewriteCustomMetricsResponse)
+			return
+		} else if isExternalMetricsRequest(registerCtx, info) {
+			handleMetricsAPIRequest(registerCtx, w, req, info, translateExternalMetricsRequest, nil)
+			return
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
+
+func isCustomMetricsRequest(ctx *synccontext.RegisterContext, r *request.RequestInfo) bool {
+	return ctx.Config.Integrations.MetricsServer.CustomMetrics.Enabled && r.IsResourceRequest && r.APIGroup == CustomMetricsGroupVersions[0].Group
+}
+
+func isExternalMetricsRequest(ctx *synccontext.RegisterContext, r *request.RequestInfo) bool {
+	return ctx.Config.Integrations.MetricsServer.ExternalMetrics.Enabled && r.IsResourceRequest && r.APIGroup == ExternalMetricsGroupVersion.Group
+}
+
+// metricsRequest holds the virtual and translated host object of a metrics request
+type metricsRequest struct {
+	info *request.RequestInfo
+
+	// hostName is the translated name of the object the metrics are requested for
+	hostName string
+}
+
+type translateRequestFunc func(ctx *synccontext.SyncContext, req *http.Request, metricsReq *metricsRequest) error
+
+type rewriteResponseFunc func(ctx *synccontext.SyncContext, metricsReq *metricsRequest, data []byte) (interface{}, error)
+
+func handleMetricsAPIRequest(
+	ctx *synccontext.RegisterContext,
+	w http.ResponseWriter,
+	req *http.Request,
+	info *request.RequestInfo,
+	translateRequest translateRequestFunc,
+	rewriteResponse rewriteResponseFunc,
+) {
+	// metrics of cluster scoped objects would expose the host cluster
+	if info.Namespace == "" {
+		requestpkg.FailWithStatus(w, req, http.StatusForbidden, fmt.Errorf("only namespaced metrics can be accessed within the vCluster"))
+		return
+	}
+
+	syncContext := ctx.ToSyncContext("metrics-api-proxy")
+	metricsReq := &metricsRequest{info: info}
+	err := translateRequest(syncContext, req, metricsReq)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusBadRequest, err)
+		return
+	}
+
+	proxyHandler, err := handler.Handler("", ctx.PhysicalManager.GetConfig(), nil)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	}
+
+	// the responses are rewritten as json, so we don't accept protobuf here
+	req.Header.Del("Authorization")
+	req.Header.Set("Accept", "application/json")
+	code, header, data, err := filters.ExecuteRequest(req, proxyHandler)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	} else if code != http.StatusOK || rewriteResponse == nil {
+		filters.WriteWithHeader(w, code, header, data)
+		return
+	}
+
+	response, err := rewriteResponse(syncContext, metricsReq, data)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	}
+
+	responsewriters.WriteRawJSON(http.StatusOK, response, w)
+}
+
+// translateCustomMetricsRequest translates requests in the form
+// /apis/custom.metrics.k8s.io/<version>/namespaces/<namespace>/<resource>/<name>/<metric> or
+// /apis/custom.metrics.k8s.io/<version>/namespaces/<namespace>/metrics/<metric>
+func translateCustomMetricsRequest(ctx *synccontext.SyncContext, req *http.Request, metricsReq *metricsRequest) error {
+	splitted := strings.Split(req.URL.Path, "/")
+	if len(splitted) < 8 || splitted[4] != "namespaces" {
+		return fmt.Errorf("unexpected custom metrics path %s", req.URL.Path)
+	}
+
+	info := metricsReq.info
+	splitted[5] = translate.Default.HostNamespace(info.Namespace)
+	if info.Resource != NamespaceMetricsResource && info.Name != "" && info.Name != "*" {
+		metricsReq.hostName = hostObjectName(ctx, info.Resource, info.Name, info.Namespace)
+		splitted[7] = metricsReq.hostName
+	}
+	req.URL.Path = strings.Join(splitted, "/")
+
+	// the label selector selects the objects the metrics are requested for
+	return translateLabelSelectors(ctx, req)
+}
+
+// hostObjectName translates the name of the object with the given resource. Objects that are not synced by vCluster
+// are expected to exist in the host cluster under their translated name.
+func hostObjectName(ctx *synccontext.SyncContext, resource, vName, vNamespace string) string {
+	gvk, err := ctx.VirtualClient.RESTMapper().KindFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err == nil && ctx.Mappings.Has(gvk) {
+		return mappings.VirtualToHostName(ctx, vName, vNamespace, gvk)
+	}
+
+	return translate.Default.HostName(vName, vNamespace)
+}
+
+// rewriteCustomMetricsResponse translates the described objects of the returned metric values back to virtual objects
+// and drops all values of objects that don't belong to the requested virtual namespace
+func rewriteCustomMetricsResponse(ctx *synccontext.SyncContext, metricsReq *metricsRequest, data []byte) (interface{}, error) {
+	metricValueList := map[string]interface{}{}
+	err := json.Unmarshal(data, &metricValueList)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal metric value list: %w", err)
+	}
+
+	items, _ := metricValueList["items"].([]interface{})
+	newItems := []interface{}{}
+	for _, item := range items {
+		metricValue, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		describedObject, ok := metricValue["describedObject"].(map[string]interface{})
+		if !ok || !rewriteDescribedObject(ctx, metricsReq, describedObject) {
+			continue
+		}
+
+		newItems = append(newItems, metricValue)
+	}
+	metricValueList["items"] = newItems
+
+	return metricValueList, nil
+}
+
+func rewriteDescribedObject(ctx *synccontext.SyncContext, metricsReq *metricsRequest, describedObject map[string]interface{}) bool {
+	vNamespace := metricsReq.info.Namespace
+	apiVersion, _ := describedObject["apiVersion"].(string)
+	kind, _ := describedObject["kind"].(string)
+	name, _ := describedObject["name"].(string)
+	namespace, _ := describedObject["namespace"].(string)
+
+	// namespace metrics describe the translated namespace
+	if kind == "Namespace" {
+		if metricsReq.info.Resource != NamespaceMetricsResource || name != translate.Default.HostNamespace(vNamespace) {
+			return false
+		}
+
+		describedObject["name"] = vNamespace
+		return true
+	}
+
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	if ctx.Mappings.Has(gvk) {
+		vName := mappings.HostToVirtual(ctx, name, namespace, nil, gvk)
+		if vName.Name == "" || vName.Namespace != vNamespace {
+			return false
+		}
+
+		describedObject["name"] = vName.Name
+		describedObject["namespace"] = vName.Namespace
+		return true
+	}
+
+	// objects that are not synced by vCluster can only be matched by the requested name
+	if metricsReq.hostName == "" || name != metricsReq.hostName {
+		return false
+	}
+
+	describedObject["name"] = metricsReq.info.Name
+	describedObject["namespace"] = vNamespace
+	return true
+}
+
+// translateExternalMetricsRequest translates requests in the form
+// /apis/external.metrics.k8s.io/<version>/namespaces/<namespace>/<metric>. The label selector selects metric labels
+// and is not translated, except for the scaled object name KEDA selects its metrics with.
+func translateExternalMetricsRequest(ctx *synccontext.SyncContext, req *http.Request, metricsReq *metricsRequest) error {
+	splitted := strings.Split(req.URL.Path, "/")
+	if len(splitted) < 7 || splitted[4] != "namespaces" {
+		return fmt.Errorf("unexpected external metrics path %s", req.URL.Path)
+	}
+
+	vNamespace := metricsReq.info.Namespace
+	splitted[5] = translate.Default.HostNamespace(vNamespace)
+	req.URL.Path = strings.Join(splitted, "/")
+
+	query := req.URL.Query()
+	if query.Get(LabelSelectorQueryParam) == "" {
+		return nil
+	}
+
+	translatedLabelSelectors, err := rewriteLabelSelector(query.Get(LabelSelectorQueryParam), func(key string, values []string) (string, []string) {
+		if key != ScaledObjectLabel {
+			return key, values
+		}
+
+		hostValues := make([]string, 0, len(values))
+		for _, value := range values {
+			if ctx.Mappings.Has(mappings.ScaledObjects()) {
+				hostValues = append(hostValues, mappings.VirtualToHostName(ctx, value, vNamespace, mappings.ScaledObjects()))
+			} else {
+				hostValues = append(hostValues, translate.Default.HostName(value, vNamespace))
+			}
+		}
+
+		return key, hostValues
+	})
+	if err != nil {
+		return err
+	}
+
+	query.Set(LabelSelectorQueryParam, translatedLabelSelectors)
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
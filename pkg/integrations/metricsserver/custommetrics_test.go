@@ -0,0 +1,76 @@
+package metricsserver
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func TestTranslateExternalMetricsRequest(t *testing.T) {
+	registerCtx := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), testingutil.NewFakeClient(scheme.Scheme), testingutil.NewFakeClient(scheme.Scheme))
+	syncCtx := registerCtx.ToSyncContext("metrics-api-proxy")
+
+	req := httptest.NewRequest("GET", "/apis/external.metrics.k8s.io/v1beta1/namespaces/default/s0-rabbitmq-jobs?labelSelector=scaledobject.keda.sh%2Fname%3Dworker", nil)
+	err := translateExternalMetricsRequest(syncCtx, req, &metricsRequest{info: &request.RequestInfo{Namespace: "default"}})
+	assert.NilError(t, err)
+	assert.Equal(t, req.URL.Path, "/apis/external.metrics.k8s.io/v1beta1/namespaces/"+syncertesting.DefaultTestTargetNamespace+"/s0-rabbitmq-jobs")
+	assert.Equal(t, req.URL.Query().Get(LabelSelectorQueryParam), ScaledObjectLabel+"="+translate.Default.HostName("worker", "default"))
+}
+
+func TestRewriteCustomMetricsResponse(t *testing.T) {
+	registerCtx := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), testingutil.NewFakeClient(scheme.Scheme), testingutil.NewFakeClient(scheme.Scheme))
+	syncCtx := registerCtx.ToSyncContext("metrics-api-proxy")
+
+	testCases := []struct {
+		name       string
+		metricsReq *metricsRequest
+		data       string
+		expected   []interface{}
+	}{
+		{
+			name:       "namespace metric",
+			metricsReq: &metricsRequest{info: &request.RequestInfo{Namespace: "default", Resource: NamespaceMetricsResource, Name: "queue_length"}},
+			data:       `{"kind":"MetricValueList","items":[{"describedObject":{"kind":"Namespace","apiVersion":"/v1","name":"` + syncertesting.DefaultTestTargetNamespace + `"},"metric":{"name":"queue_length"},"value":"3"}]}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"describedObject": map[string]interface{}{"kind": "Namespace", "apiVersion": "/v1", "name": "default"},
+					"metric":          map[string]interface{}{"name": "queue_length"},
+					"value":           "3",
+				},
+			},
+		},
+		{
+			name:       "unsynced object",
+			metricsReq: &metricsRequest{info: &request.RequestInfo{Namespace: "default", Resource: "queues.example.com", Name: "jobs"}, hostName: translate.Default.HostName("jobs", "default")},
+			data:       `{"kind":"MetricValueList","items":[{"describedObject":{"kind":"Queue","apiVersion":"example.com/v1","namespace":"` + syncertesting.DefaultTestTargetNamespace + `","name":"` + translate.Default.HostName("jobs", "default") + `"},"value":"1"},{"describedObject":{"kind":"Queue","apiVersion":"example.com/v1","namespace":"` + syncertesting.DefaultTestTargetNamespace + `","name":"other"},"value":"2"}]}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"describedObject": map[string]interface{}{"kind": "Queue", "apiVersion": "example.com/v1", "namespace": "default", "name": "jobs"},
+					"value":           "1",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response, err := rewriteCustomMetricsResponse(syncCtx, testCase.metricsReq, []byte(testCase.data))
+			assert.NilError(t, err)
+			assert.DeepEqual(t, response.(map[string]interface{})["items"], testCase.expected)
+		})
+	}
+}
+
+func TestRewriteLabelSelector(t *testing.T) {
+	selector, err := rewriteLabelSelector("app=worker,tier in (backend,jobs)", func(key string, values []string) (string, []string) {
+		return "prefix-" + key, values
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, selector, "prefix-app=worker,prefix-tier in (backend,jobs)")
+}
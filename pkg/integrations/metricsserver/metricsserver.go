@@ -62,15 +62,21 @@ func Register(ctx *synccontext.ControllerContext) error {
 		})
 	}
 
-	return nil
+	return registerMetricsAPIs(ctx)
 }
 
 func RegisterOrDeregisterAPIService(ctx *synccontext.ControllerContext) error {
+	var err error
 	if ctx.Config.Integrations.MetricsServer.Enabled {
-		return apiservice.RegisterAPIService(ctx, "metrics-server", hostPort, GroupVersion)
+		err = apiservice.RegisterAPIService(ctx, "metrics-server", hostPort, GroupVersion)
+	} else {
+		err = apiservice.DeregisterAPIService(ctx, "metrics-server", GroupVersion)
+	}
+	if err != nil {
+		return err
 	}
 
-	return apiservice.DeregisterAPIService(ctx, GroupVersion)
+	return registerOrDeregisterMetricsAPIs(ctx)
 }
 
 func WithMetricsServerProxy(
@@ -473,24 +479,37 @@ func getVirtualNodes(ctx context.Context, vClient client.Client) ([]corev1.Node,
 }
 
 func translateLabelSelectors(ctx *synccontext.SyncContext, req *http.Request) error {
-	translatedSelectors := make(map[string]string)
-
 	query := req.URL.Query()
-	labelSelectors := query.Get(LabelSelectorQueryParam)
-	if labelSelectors != "" {
-		selectors, err := labels.ConvertSelectorToLabelsMap(labelSelectors)
+	translatedLabelSelectors, err := rewriteLabelSelector(query.Get(LabelSelectorQueryParam), func(key string, values []string) (string, []string) {
+		return translate.Default.HostLabel(ctx, key), values
+	})
+	if err != nil {
+		return err
+	}
+
+	query.Set(LabelSelectorQueryParam, translatedLabelSelectors)
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// rewriteLabelSelector parses the given label selector and rewrites the key and values of each requirement
+func rewriteLabelSelector(selector string, rewrite func(key string, values []string) (string, []string)) (string, error) {
+	parsedSelector, err := labels.Parse(selector)
+	if err != nil {
+		return "", err
+	}
+
+	requirements, _ := parsedSelector.Requirements()
+	newSelector := labels.NewSelector()
+	for _, requirement := range requirements {
+		key, values := rewrite(requirement.Key(), requirement.Values().List())
+		newRequirement, err := labels.NewRequirement(key, requirement.Operator(), values)
 		if err != nil {
-			return err
+			return "", err
 		}
 
-		for k, v := range selectors {
-			translatedKey := translate.Default.HostLabel(ctx, k)
-			translatedSelectors[translatedKey] = v
-		}
+		newSelector = newSelector.Add(*newRequirement)
 	}
 
-	translatedLabelSelectors := labels.SelectorFromSet(translatedSelectors)
-	query.Set(LabelSelectorQueryParam, translatedLabelSelectors.String())
-	req.URL.RawQuery = query.Encode()
-	return nil
+	return newSelector.String(), nil
 }
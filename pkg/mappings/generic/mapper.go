@@ -38,11 +38,19 @@ func NewMapperWithObject(ctx *synccontext.RegisterContext, obj client.Object, tr
 	mapperOptions := getOptions(options...)
 	if !mapperOptions.SkipIndex {
 		err = ctx.VirtualManager.GetFieldIndexer().IndexField(ctx, obj.DeepCopyObject().(client.Object), constants.IndexByPhysicalName, func(rawObj client.Object) []string {
+			// prefer the recorded host name, so the index agrees with the mappings store
+			pName, ok := RecordedHostName(ctx.Mappings, gvk, types.NamespacedName{Name: rawObj.GetName(), Namespace: rawObj.GetNamespace()})
+			if !ok {
+				pName = types.NamespacedName{
+					Namespace: translate.Default.HostNamespace(rawObj.GetNamespace()),
+					Name:      translateName(rawObj.GetName(), rawObj.GetNamespace(), rawObj),
+				}
+			}
 			if rawObj.GetNamespace() != "" {
-				return []string{translate.Default.HostNamespace(rawObj.GetNamespace()) + "/" + translateName(rawObj.GetName(), rawObj.GetNamespace(), rawObj)}
+				return []string{pName.Namespace + "/" + pName.Name}
 			}
 
-			return []string{translateName(rawObj.GetName(), rawObj.GetNamespace(), rawObj)}
+			return []string{pName.Name}
 		})
 		if err != nil {
 			return nil, fmt.Errorf("index field: %w", err)
@@ -69,7 +77,12 @@ func (n *mapper) GroupVersionKind() schema.GroupVersionKind {
 	return n.gvk
 }
 
-func (n *mapper) VirtualToHost(_ *synccontext.SyncContext, req types.NamespacedName, vObj client.Object) types.NamespacedName {
+func (n *mapper) VirtualToHost(ctx *synccontext.SyncContext, req types.NamespacedName, vObj client.Object) types.NamespacedName {
+	// check if we have recorded a mapping for this object already
+	if pName, ok := RecordedHostName(mappingsOf(ctx), n.gvk, req); ok {
+		return pName
+	}
+
 	return types.NamespacedName{
 		Namespace: translate.Default.HostNamespace(req.Namespace),
 		Name:      n.translateName(req.Name, req.Namespace, vObj),
@@ -77,6 +90,11 @@ func (n *mapper) VirtualToHost(_ *synccontext.SyncContext, req types.NamespacedN
 }
 
 func (n *mapper) HostToVirtual(ctx *synccontext.SyncContext, req types.NamespacedName, pObj client.Object) types.NamespacedName {
+	// check if we have recorded a mapping for this object already
+	if vName, ok := RecordedVirtualName(mappingsOf(ctx), n.gvk, req); ok {
+		return vName
+	}
+
 	if pObj != nil {
 		pAnnotations := pObj.GetAnnotations()
 		if pAnnotations != nil && pAnnotations[translate.NameAnnotation] != "" {
@@ -111,3 +129,30 @@ func (n *mapper) HostToVirtual(ctx *synccontext.SyncContext, req types.Namespace
 func (n *mapper) IsManaged(ctx *synccontext.SyncContext, pObj client.Object) (bool, error) {
 	return translate.Default.IsManaged(ctx, pObj), nil
 }
+
+// RecordedHostName returns the host name recorded in the mappings store for the given virtual object. Custom
+// mappers should check it before translating the name themselves, so all mappers agree with the store.
+func RecordedHostName(mappings synccontext.MappingsRegistry, gvk schema.GroupVersionKind, vName types.NamespacedName) (types.NamespacedName, bool) {
+	if mappings == nil || mappings.Store() == nil {
+		return types.NamespacedName{}, false
+	}
+
+	return mappings.Store().VirtualToHostName(gvk, vName)
+}
+
+// RecordedVirtualName returns the virtual name recorded in the mappings store for the given host object
+func RecordedVirtualName(mappings synccontext.MappingsRegistry, gvk schema.GroupVersionKind, pName types.NamespacedName) (types.NamespacedName, bool) {
+	if mappings == nil || mappings.Store() == nil {
+		return types.NamespacedName{}, false
+	}
+
+	return mappings.Store().HostToVirtualName(gvk, pName)
+}
+
+func mappingsOf(ctx *synccontext.SyncContext) synccontext.MappingsRegistry {
+	if ctx == nil {
+		return nil
+	}
+
+	return ctx.Mappings
+}
@@ -2,13 +2,17 @@ package mappings
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -16,18 +20,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewMappingsRegistry() synccontext.MappingsRegistry {
+func NewMappingsRegistry(store synccontext.MappingsStore) synccontext.MappingsRegistry {
 	return &Registry{
 		mappers: map[schema.GroupVersionKind]synccontext.Mapper{},
+		store:   store,
 	}
 }
 
 type Registry struct {
 	mappers map[schema.GroupVersionKind]synccontext.Mapper
+	store   synccontext.MappingsStore
 
 	m sync.Mutex
 }
 
+func (m *Registry) Store() synccontext.MappingsStore {
+	return m.store
+}
+
 func (m *Registry) AddMapper(mapper synccontext.Mapper) error {
 	m.m.Lock()
 	defer m.m.Unlock()
@@ -44,6 +54,21 @@ func (m *Registry) Has(gvk schema.GroupVersionKind) bool {
 	return ok
 }
 
+func (m *Registry) List() []synccontext.Mapper {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	retMappers := make([]synccontext.Mapper, 0, len(m.mappers))
+	for _, mapper := range m.mappers {
+		retMappers = append(retMappers, mapper)
+	}
+	sort.Slice(retMappers, func(i, j int) bool {
+		return retMappers[i].GroupVersionKind().String() < retMappers[j].GroupVersionKind().String()
+	})
+
+	return retMappers
+}
+
 func (m *Registry) ByGVK(gvk schema.GroupVersionKind) (synccontext.Mapper, error) {
 	m.m.Lock()
 	defer m.m.Unlock()
@@ -108,6 +133,10 @@ func Endpoints() schema.GroupVersionKind {
 	return corev1.SchemeGroupVersion.WithKind("Endpoints")
 }
 
+func EndpointSlices() schema.GroupVersionKind {
+	return discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice")
+}
+
 func Services() schema.GroupVersionKind {
 	return corev1.SchemeGroupVersion.WithKind("Service")
 }
@@ -128,6 +157,10 @@ func StorageClasses() schema.GroupVersionKind {
 	return storagev1.SchemeGroupVersion.WithKind("StorageClass")
 }
 
+func RuntimeClasses() schema.GroupVersionKind {
+	return nodev1.SchemeGroupVersion.WithKind("RuntimeClass")
+}
+
 func IngressClasses() schema.GroupVersionKind {
 	return networkingv1.SchemeGroupVersion.WithKind("IngressClass")
 }
@@ -148,6 +181,125 @@ func PriorityClasses() schema.GroupVersionKind {
 	return schedulingv1.SchemeGroupVersion.WithKind("PriorityClass")
 }
 
+func ResourceClaims() schema.GroupVersionKind {
+	return resourcev1alpha2.SchemeGroupVersion.WithKind("ResourceClaim")
+}
+
+func ResourceClaimTemplates() schema.GroupVersionKind {
+	return resourcev1alpha2.SchemeGroupVersion.WithKind("ResourceClaimTemplate")
+}
+
+func ResourceClasses() schema.GroupVersionKind {
+	return resourcev1alpha2.SchemeGroupVersion.WithKind("ResourceClass")
+}
+
+func ResourceSlices() schema.GroupVersionKind {
+	return resourcev1alpha2.SchemeGroupVersion.WithKind("ResourceSlice")
+}
+
+func GatewayClasses() schema.GroupVersionKind {
+	return gatewayAPIGroupVersion.WithKind("GatewayClass")
+}
+
+func Gateways() schema.GroupVersionKind {
+	return gatewayAPIGroupVersion.WithKind("Gateway")
+}
+
+func HTTPRoutes() schema.GroupVersionKind {
+	return gatewayAPIGroupVersion.WithKind("HTTPRoute")
+}
+
+func GRPCRoutes() schema.GroupVersionKind {
+	return gatewayAPIGroupVersion.WithKind("GRPCRoute")
+}
+
+func ReferenceGrants() schema.GroupVersionKind {
+	return schema.GroupVersion{Group: gatewayAPIGroupVersion.Group, Version: "v1beta1"}.WithKind("ReferenceGrant")
+}
+
+func ServiceMonitors() schema.GroupVersionKind {
+	return prometheusOperatorGroupVersion.WithKind("ServiceMonitor")
+}
+
+func PodMonitors() schema.GroupVersionKind {
+	return prometheusOperatorGroupVersion.WithKind("PodMonitor")
+}
+
+func PrometheusRules() schema.GroupVersionKind {
+	return prometheusOperatorGroupVersion.WithKind("PrometheusRule")
+}
+
+func Certificates() schema.GroupVersionKind {
+	return certManagerGroupVersion.WithKind("Certificate")
+}
+
+func Issuers() schema.GroupVersionKind {
+	return certManagerGroupVersion.WithKind("Issuer")
+}
+
+func ClusterIssuers() schema.GroupVersionKind {
+	return certManagerGroupVersion.WithKind("ClusterIssuer")
+}
+
+func IstioVirtualServices() schema.GroupVersionKind {
+	return istioNetworkingGroupVersion.WithKind("VirtualService")
+}
+
+func IstioDestinationRules() schema.GroupVersionKind {
+	return istioNetworkingGroupVersion.WithKind("DestinationRule")
+}
+
+func IstioGateways() schema.GroupVersionKind {
+	return istioNetworkingGroupVersion.WithKind("Gateway")
+}
+
+func IstioPeerAuthentications() schema.GroupVersionKind {
+	return istioSecurityGroupVersion.WithKind("PeerAuthentication")
+}
+
+func IstioAuthorizationPolicies() schema.GroupVersionKind {
+	return istioSecurityGroupVersion.WithKind("AuthorizationPolicy")
+}
+
+func ExternalSecrets() schema.GroupVersionKind {
+	return externalSecretsGroupVersion.WithKind("ExternalSecret")
+}
+
+func SecretStores() schema.GroupVersionKind {
+	return externalSecretsGroupVersion.WithKind("SecretStore")
+}
+
+func ScaledObjects() schema.GroupVersionKind {
+	return kedaGroupVersion.WithKind("ScaledObject")
+}
+
+func TriggerAuthentications() schema.GroupVersionKind {
+	return kedaGroupVersion.WithKind("TriggerAuthentication")
+}
+
+// externalSecretsGroupVersion and kedaGroupVersion are the External Secrets Operator and KEDA group versions, the types
+// are not vendored so we use unstructured objects
+var (
+	externalSecretsGroupVersion = schema.GroupVersion{Group: "external-secrets.io", Version: "v1beta1"}
+	kedaGroupVersion            = schema.GroupVersion{Group: "keda.sh", Version: "v1alpha1"}
+)
+
+// istioNetworkingGroupVersion and istioSecurityGroupVersion are the Istio group versions, the types are not vendored
+// so we use unstructured objects
+var (
+	istioNetworkingGroupVersion = schema.GroupVersion{Group: "networking.istio.io", Version: "v1beta1"}
+	istioSecurityGroupVersion   = schema.GroupVersion{Group: "security.istio.io", Version: "v1beta1"}
+)
+
+// certManagerGroupVersion is the cert-manager group version, the types are not vendored so we use unstructured objects
+var certManagerGroupVersion = schema.GroupVersion{Group: "cert-manager.io", Version: "v1"}
+
+// prometheusOperatorGroupVersion is the Prometheus Operator group version, the types are not vendored so we use unstructured objects
+var prometheusOperatorGroupVersion = schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+
+// gatewayAPIGroupVersion is the Gateway API group version, the types are not vendored so we use unstructured objects
+var gatewayAPIGroupVersion = schema.GroupVersion{Group: "gateway.networking.k8s.io", Version: "v1"}
+
 func VirtualToHostName(ctx *synccontext.SyncContext, vName, vNamespace string, gvk schema.GroupVersionKind) string {
 	return VirtualToHost(ctx, vName, vNamespace, gvk).Name
 }
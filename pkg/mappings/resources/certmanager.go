@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+)
+
+func CreateCertificatesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.Certificates())
+}
+
+func CreateIssuersMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.Issuers())
+}
+
+func CreateClusterIssuersMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	obj, err := ensureHostCRDKind(ctx, mappings.ClusterIssuers())
+	if err != nil {
+		return nil, err
+	}
+
+	return generic.NewMirrorMapper(obj)
+}
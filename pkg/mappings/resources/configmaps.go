@@ -1,7 +1,6 @@
 package resources
 
 import (
-	"github.com/loft-sh/vcluster/pkg/constants"
 	"github.com/loft-sh/vcluster/pkg/mappings/generic"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
@@ -11,17 +10,12 @@ import (
 )
 
 func CreateConfigMapsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
-	mapper, err := generic.NewMapper(ctx, &corev1.ConfigMap{}, translate.Default.HostName, generic.SkipIndex())
-	if err != nil {
-		return nil, err
-	}
-
-	err = ctx.VirtualManager.GetFieldIndexer().IndexField(ctx, &corev1.ConfigMap{}, constants.IndexByPhysicalName, func(rawObj client.Object) []string {
-		if !translate.Default.SingleNamespaceTarget() && rawObj.GetName() == "kube-root-ca.crt" {
-			return []string{translate.Default.HostNamespace(rawObj.GetNamespace()) + "/" + translate.SafeConcatName("vcluster", "kube-root-ca.crt", "x", translate.VClusterName)}
+	mapper, err := generic.NewMapper(ctx, &corev1.ConfigMap{}, func(vName, vNamespace string) string {
+		if !translate.Default.SingleNamespaceTarget() && vName == "kube-root-ca.crt" {
+			return translate.SafeConcatName("vcluster", "kube-root-ca.crt", "x", translate.VClusterName)
 		}
 
-		return []string{translate.Default.HostNamespace(rawObj.GetNamespace()) + "/" + translate.Default.HostName(rawObj.GetName(), rawObj.GetNamespace())}
+		return translate.Default.HostName(vName, vNamespace)
 	})
 	if err != nil {
 		return nil, err
@@ -36,17 +30,6 @@ type configMapsMapper struct {
 	synccontext.Mapper
 }
 
-func (s *configMapsMapper) VirtualToHost(ctx *synccontext.SyncContext, req types.NamespacedName, vObj client.Object) types.NamespacedName {
-	if !translate.Default.SingleNamespaceTarget() && req.Name == "kube-root-ca.crt" {
-		return types.NamespacedName{
-			Name:      translate.SafeConcatName("vcluster", "kube-root-ca.crt", "x", translate.VClusterName),
-			Namespace: s.Mapper.VirtualToHost(ctx, req, vObj).Namespace,
-		}
-	}
-
-	return s.Mapper.VirtualToHost(ctx, req, vObj)
-}
-
 func (s *configMapsMapper) HostToVirtual(ctx *synccontext.SyncContext, req types.NamespacedName, pObj client.Object) types.NamespacedName {
 	if !translate.Default.SingleNamespaceTarget() && req.Name == translate.SafeConcatName("vcluster", "kube-root-ca.crt", "x", translate.VClusterName) {
 		return types.NamespacedName{
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"github.com/loft-sh/vcluster/pkg/util/clienthelper"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
@@ -33,11 +34,19 @@ func (s *csiStorageCapacitiesMapper) GroupVersionKind() schema.GroupVersionKind
 	return storagev1.SchemeGroupVersion.WithKind("CSIStorageCapacity")
 }
 
-func (s *csiStorageCapacitiesMapper) HostToVirtual(_ *synccontext.SyncContext, req types.NamespacedName, _ client.Object) types.NamespacedName {
+func (s *csiStorageCapacitiesMapper) HostToVirtual(ctx *synccontext.SyncContext, req types.NamespacedName, _ client.Object) types.NamespacedName {
+	if vName, ok := generic.RecordedVirtualName(ctx.Mappings, s.GroupVersionKind(), req); ok {
+		return vName
+	}
+
 	return types.NamespacedName{Name: translate.SafeConcatName(req.Name, "x", req.Namespace), Namespace: "kube-system"}
 }
 
 func (s *csiStorageCapacitiesMapper) VirtualToHost(ctx *synccontext.SyncContext, req types.NamespacedName, vObj client.Object) types.NamespacedName {
+	if pName, ok := generic.RecordedHostName(ctx.Mappings, s.GroupVersionKind(), req); ok {
+		return pName
+	}
+
 	// if the virtual object is annotated with the physical name and namespace, return that
 	if vObj != nil {
 		vAnnotations := vObj.GetAnnotations()
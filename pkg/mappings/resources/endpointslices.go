@@ -0,0 +1,12 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+func CreateEndpointSlicesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMapper(ctx, &discoveryv1.EndpointSlice{}, translate.Default.HostName)
+}
@@ -0,0 +1,14 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+)
+
+func CreateExternalSecretsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.ExternalSecrets())
+}
+
+func CreateSecretStoresMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.SecretStores())
+}
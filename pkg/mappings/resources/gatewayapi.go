@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func CreateGatewayClassesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	obj, err := ensureHostCRDKind(ctx, mappings.GatewayClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return generic.NewMirrorMapper(obj)
+}
+
+func CreateGatewaysMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.Gateways())
+}
+
+func CreateHTTPRoutesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.HTTPRoutes())
+}
+
+func CreateGRPCRoutesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.GRPCRoutes())
+}
+
+func CreateReferenceGrantsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.ReferenceGrants())
+}
+
+// createHostCRDMapper creates a mapper for a namespaced kind whose CRD is copied from the host cluster
+func createHostCRDMapper(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind) (synccontext.Mapper, error) {
+	obj, err := ensureHostCRDKind(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return generic.NewMapper(ctx, obj, translate.Default.HostName)
+}
+
+// ensureHostCRDKind copies the CRD for the kind from the host cluster into the virtual cluster
+func ensureHostCRDKind(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	_, _, err := translate.EnsureCRDFromPhysicalCluster(ctx, ctx.PhysicalManager.GetConfig(), ctx.VirtualManager.GetConfig(), gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj, nil
+}
@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+)
+
+func CreateIstioVirtualServicesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.IstioVirtualServices())
+}
+
+func CreateIstioDestinationRulesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.IstioDestinationRules())
+}
+
+func CreateIstioGatewaysMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.IstioGateways())
+}
+
+func CreateIstioPeerAuthenticationsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.IstioPeerAuthentications())
+}
+
+func CreateIstioAuthorizationPoliciesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.IstioAuthorizationPolicies())
+}
@@ -0,0 +1,14 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+)
+
+func CreateScaledObjectsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.ScaledObjects())
+}
+
+func CreateTriggerAuthenticationsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return createHostCRDMapper(ctx, mappings.TriggerAuthentications())
+}
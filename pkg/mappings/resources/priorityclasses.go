@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/mappings/generic"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func CreatePriorityClassesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
-	if !ctx.Config.Sync.ToHost.PriorityClasses.Enabled {
+	if ctx.Config.Sync.FromHost.PriorityClasses.Enabled {
+		return newPriorityClassTableMapper(ctx.Config.Sync.FromHost.PriorityClasses.Mappings), nil
+	} else if !ctx.Config.Sync.ToHost.PriorityClasses.Enabled {
 		return generic.NewMirrorMapper(&schedulingv1.PriorityClass{})
 	}
 
@@ -17,3 +23,39 @@ func CreatePriorityClassesMapper(ctx *synccontext.RegisterContext) (synccontext.
 		return translate.Default.HostNameCluster(vName)
 	})
 }
+
+func newPriorityClassTableMapper(virtualToHost map[string]string) synccontext.Mapper {
+	hostToVirtual := map[string]string{}
+	for virtualName, hostName := range virtualToHost {
+		hostToVirtual[hostName] = virtualName
+	}
+
+	return &priorityClassTableMapper{
+		virtualToHost: virtualToHost,
+		hostToVirtual: hostToVirtual,
+	}
+}
+
+// priorityClassTableMapper maps priority classes according to the table configured in
+// sync.fromHost.priorityClasses.mappings. Names that are not within the table are not mapped.
+type priorityClassTableMapper struct {
+	virtualToHost map[string]string
+	hostToVirtual map[string]string
+}
+
+func (p *priorityClassTableMapper) GroupVersionKind() schema.GroupVersionKind {
+	return mappings.PriorityClasses()
+}
+
+func (p *priorityClassTableMapper) VirtualToHost(_ *synccontext.SyncContext, req types.NamespacedName, _ client.Object) types.NamespacedName {
+	return types.NamespacedName{Name: p.virtualToHost[req.Name]}
+}
+
+func (p *priorityClassTableMapper) HostToVirtual(_ *synccontext.SyncContext, req types.NamespacedName, _ client.Object) types.NamespacedName {
+	return types.NamespacedName{Name: p.hostToVirtual[req.Name]}
+}
+
+func (p *priorityClassTableMapper) IsManaged(_ *synccontext.SyncContext, pObj client.Object) (bool, error) {
+	_, ok := p.hostToVirtual[pObj.GetName()]
+	return ok, nil
+}
@@ -20,9 +20,16 @@ func getMappers(ctx *synccontext.RegisterContext) []BuildMapper {
 		isEnabled(ctx.Config.Sync.FromHost.CSIDrivers.Enabled == "true", CreateCSIDriversMapper),
 		isEnabled(ctx.Config.Sync.FromHost.CSIStorageCapacities.Enabled == "true", CreateCSIStorageCapacitiesMapper),
 		CreateEndpointsMapper,
+		isEnabled(ctx.Config.Sync.ToHost.EndpointSlices.Enabled, CreateEndpointSlicesMapper),
 		CreateEventsMapper,
 		CreateIngressClassesMapper,
+		isEnabled(ctx.Config.Sync.FromHost.RuntimeClasses.Enabled, CreateRuntimeClassesMapper),
 		CreateIngressesMapper,
+		isEnabled(ctx.Config.Sync.FromHost.GatewayClasses.Enabled, CreateGatewayClassesMapper),
+		isEnabled(ctx.Config.Sync.ToHost.Gateways.Enabled, CreateGatewaysMapper),
+		isEnabled(ctx.Config.Sync.ToHost.HTTPRoutes.Enabled, CreateHTTPRoutesMapper),
+		isEnabled(ctx.Config.Sync.ToHost.GRPCRoutes.Enabled, CreateGRPCRoutesMapper),
+		isEnabled(ctx.Config.Sync.ToHost.ReferenceGrants.Enabled, CreateReferenceGrantsMapper),
 		CreateNamespacesMapper,
 		CreateNetworkPoliciesMapper,
 		CreateNodesMapper,
@@ -33,6 +40,25 @@ func getMappers(ctx *synccontext.RegisterContext) []BuildMapper {
 		CreatePodDisruptionBudgetsMapper,
 		CreatePersistentVolumesMapper,
 		CreatePodsMapper,
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.ServiceMonitors.Enabled, CreateServiceMonitorsMapper),
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.PodMonitors.Enabled, CreatePodMonitorsMapper),
+		isEnabled(ctx.Config.Integrations.PrometheusOperator.Enabled && ctx.Config.Integrations.PrometheusOperator.Sync.PrometheusRules.Enabled, CreatePrometheusRulesMapper),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.ToHost.Certificates.Enabled, CreateCertificatesMapper),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.ToHost.Issuers.Enabled, CreateIssuersMapper),
+		isEnabled(ctx.Config.Integrations.CertManager.Enabled && ctx.Config.Integrations.CertManager.Sync.FromHost.ClusterIssuers.Enabled, CreateClusterIssuersMapper),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.VirtualServices.Enabled, CreateIstioVirtualServicesMapper),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.DestinationRules.Enabled, CreateIstioDestinationRulesMapper),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.Gateways.Enabled, CreateIstioGatewaysMapper),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.PeerAuthentications.Enabled, CreateIstioPeerAuthenticationsMapper),
+		isEnabled(ctx.Config.Integrations.Istio.Enabled && ctx.Config.Integrations.Istio.Sync.AuthorizationPolicies.Enabled, CreateIstioAuthorizationPoliciesMapper),
+		isEnabled(ctx.Config.Integrations.ExternalSecrets.Enabled && ctx.Config.Integrations.ExternalSecrets.Sync.ExternalSecrets.Enabled, CreateExternalSecretsMapper),
+		isEnabled(ctx.Config.Integrations.ExternalSecrets.Enabled && ctx.Config.Integrations.ExternalSecrets.Sync.Stores.Enabled, CreateSecretStoresMapper),
+		isEnabled(ctx.Config.Integrations.KEDA.Enabled && ctx.Config.Integrations.KEDA.Sync.ScaledObjects.Enabled, CreateScaledObjectsMapper),
+		isEnabled(ctx.Config.Integrations.KEDA.Enabled && ctx.Config.Integrations.KEDA.Sync.TriggerAuthentications.Enabled, CreateTriggerAuthenticationsMapper),
+		isEnabled(ctx.Config.Sync.ToHost.ResourceClaims.Enabled, CreateResourceClaimsMapper),
+		isEnabled(ctx.Config.Sync.ToHost.ResourceClaimTemplates.Enabled, CreateResourceClaimTemplatesMapper),
+		isEnabled(ctx.Config.Sync.FromHost.ResourceClasses.Enabled, CreateResourceClassesMapper),
+		isEnabled(ctx.Config.Sync.FromHost.ResourceSlices.Enabled, CreateResourceSlicesMapper),
 		CreateStorageClassesMapper,
 		CreateVolumeSnapshotClassesMapper,
 		CreateVolumeSnapshotContentsMapper,
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+)
+
+func CreateResourceClaimsMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMapper(ctx, &resourcev1alpha2.ResourceClaim{}, translate.Default.HostName)
+}
+
+func CreateResourceClaimTemplatesMapper(ctx *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMapper(ctx, &resourcev1alpha2.ResourceClaimTemplate{}, translate.Default.HostName)
+}
+
+func CreateResourceClassesMapper(_ *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMirrorMapper(&resourcev1alpha2.ResourceClass{})
+}
+
+func CreateResourceSlicesMapper(_ *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMirrorMapper(&resourcev1alpha2.ResourceSlice{})
+}
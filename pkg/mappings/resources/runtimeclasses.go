@@ -0,0 +1,11 @@
+package resources
+
+import (
+	"github.com/loft-sh/vcluster/pkg/mappings/generic"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	nodev1 "k8s.io/api/node/v1"
+)
+
+func CreateRuntimeClassesMapper(_ *synccontext.RegisterContext) (synccontext.Mapper, error) {
+	return generic.NewMirrorMapper(&nodev1.RuntimeClass{})
+}
@@ -0,0 +1,296 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/compress"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	mappingsKey = "mappings"
+	shardsKey   = "shards"
+	checksumKey = "checksum"
+)
+
+// MaxShardSize is the maximum size of the compressed mappings saved within a single config map. Bigger
+// mappings are split across multiple config maps to stay below the config map size limit of 1MiB.
+var MaxShardSize = 512 * 1024
+
+var errChecksumMismatch = errors.New("mappings checksum mismatch")
+
+// ConfigMapName returns the name of the host config map the mappings of the given vCluster are stored in
+func ConfigMapName(vClusterName string) string {
+	return "vc-mappings-" + vClusterName
+}
+
+// NewConfigMapBackend creates a new backend that saves the mappings of the given vCluster in config maps within the
+// host cluster. The config maps are labeled like the other vCluster objects and owned by the given owner references.
+func NewConfigMapBackend(client kubernetes.Interface, namespace, vClusterName string, ownerReferences []metav1.OwnerReference) Backend {
+	return &configMapBackend{
+		client:    client,
+		namespace: namespace,
+		name:      ConfigMapName(vClusterName),
+
+		vClusterName:    vClusterName,
+		ownerReferences: ownerReferences,
+	}
+}
+
+type configMapBackend struct {
+	client kubernetes.Interface
+
+	namespace string
+	name      string
+
+	vClusterName    string
+	ownerReferences []metav1.OwnerReference
+}
+
+// mapping is the serialized form of a single mapping within the config map
+type mapping struct {
+	Kind string `json:"k"`
+
+	VirtualNamespace string `json:"vn,omitempty"`
+	VirtualName      string `json:"v"`
+
+	HostNamespace string `json:"hn,omitempty"`
+	HostName      string `json:"h"`
+}
+
+func (c *configMapBackend) Load(ctx context.Context) ([]synccontext.NameMapping, error) {
+	var mappings []synccontext.NameMapping
+	err := retry.OnError(retry.DefaultBackoff, func(err error) bool {
+		// the leader might be saving the shards right now
+		return errors.Is(err, errChecksumMismatch)
+	}, func() error {
+		var err error
+		mappings, err = c.load(ctx)
+		return err
+	})
+	return mappings, err
+}
+
+func (c *configMapBackend) load(ctx context.Context) ([]synccontext.NameMapping, error) {
+	configMap, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get mappings config map: %w", err)
+	}
+
+	compressed := configMap.Data[mappingsKey]
+	for i := 1; i < shardCount(configMap); i++ {
+		shard, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, shardName(c.name, i), metav1.GetOptions{})
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: shard %d not found", errChecksumMismatch, i)
+			}
+
+			return nil, fmt.Errorf("get mappings config map shard %d: %w", i, err)
+		}
+
+		compressed += shard.Data[mappingsKey]
+	}
+	if configMap.Data[checksumKey] != "" && configMap.Data[checksumKey] != checksum(compressed) {
+		return nil, errChecksumMismatch
+	}
+
+	return decodeMappings(compressed)
+}
+
+func decodeMappings(compressed string) ([]synccontext.NameMapping, error) {
+	if compressed == "" {
+		return nil, nil
+	}
+
+	raw, err := compress.Uncompress(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("uncompress mappings: %w", err)
+	}
+
+	encoded := []mapping{}
+	err = json.Unmarshal([]byte(raw), &encoded)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal mappings: %w", err)
+	}
+
+	mappings := make([]synccontext.NameMapping, 0, len(encoded))
+	for _, m := range encoded {
+		gvk, _ := schema.ParseKindArg(m.Kind)
+		if gvk == nil {
+			continue
+		}
+
+		mappings = append(mappings, synccontext.NameMapping{
+			GroupVersionKind: *gvk,
+			Virtual:          types.NamespacedName{Namespace: m.VirtualNamespace, Name: m.VirtualName},
+			Host:             types.NamespacedName{Namespace: m.HostNamespace, Name: m.HostName},
+		})
+	}
+
+	return mappings, nil
+}
+
+func (c *configMapBackend) Save(ctx context.Context, mappings []synccontext.NameMapping) error {
+	encoded := make([]mapping, 0, len(mappings))
+	for _, m := range mappings {
+		encoded = append(encoded, mapping{
+			Kind:             m.GroupVersionKind.Kind + "." + m.GroupVersionKind.Version + "." + m.GroupVersionKind.Group,
+			VirtualNamespace: m.Virtual.Namespace,
+			VirtualName:      m.Virtual.Name,
+			HostNamespace:    m.Host.Namespace,
+			HostName:         m.Host.Name,
+		})
+	}
+
+	raw, err := json.Marshal(encoded)
+	if err != nil {
+		return fmt.Errorf("marshal mappings: %w", err)
+	}
+
+	compressed, err := compress.Compress(string(raw))
+	if err != nil {
+		return fmt.Errorf("compress mappings: %w", err)
+	}
+
+	shards := splitShards(compressed, MaxShardSize)
+
+	// write the additional shards first, so the main config map always points to complete shards
+	for i := 1; i < len(shards); i++ {
+		err = c.saveShard(ctx, shardName(c.name, i), map[string]string{mappingsKey: shards[i]})
+		if err != nil {
+			return fmt.Errorf("save mappings config map shard %d: %w", i, err)
+		}
+	}
+
+	oldShards := 1
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+		exists := err == nil
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return fmt.Errorf("get mappings config map: %w", err)
+			}
+
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      c.name,
+					Namespace: c.namespace,
+				},
+			}
+		} else {
+			oldShards = shardCount(configMap)
+		}
+
+		c.setMetadata(configMap)
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[mappingsKey] = shards[0]
+		configMap.Data[shardsKey] = strconv.Itoa(len(shards))
+		configMap.Data[checksumKey] = checksum(compressed)
+		if !exists {
+			_, err = c.client.CoreV1().ConfigMaps(c.namespace).Create(ctx, configMap, metav1.CreateOptions{})
+			return err
+		}
+
+		_, err = c.client.CoreV1().ConfigMaps(c.namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// remove shards that are not needed anymore
+	for i := len(shards); i < oldShards; i++ {
+		err = c.client.CoreV1().ConfigMaps(c.namespace).Delete(ctx, shardName(c.name, i), metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("delete mappings config map shard %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *configMapBackend) saveShard(ctx context.Context, name string, data map[string]string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: c.namespace,
+				},
+				Data: data,
+			}
+			c.setMetadata(configMap)
+			_, err = c.client.CoreV1().ConfigMaps(c.namespace).Create(ctx, configMap, metav1.CreateOptions{})
+			return err
+		}
+
+		c.setMetadata(configMap)
+		configMap.Data = data
+		_, err = c.client.CoreV1().ConfigMaps(c.namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// setMetadata labels the config map as part of the vCluster and sets its owner references
+func (c *configMapBackend) setMetadata(configMap *corev1.ConfigMap) {
+	if configMap.Labels == nil {
+		configMap.Labels = map[string]string{}
+	}
+	configMap.Labels["app"] = "vcluster"
+	configMap.Labels["release"] = c.vClusterName
+	if len(c.ownerReferences) > 0 {
+		configMap.OwnerReferences = c.ownerReferences
+	}
+}
+
+func shardName(name string, shard int) string {
+	return name + "-" + strconv.Itoa(shard)
+}
+
+func shardCount(configMap *corev1.ConfigMap) int {
+	shards, err := strconv.Atoi(configMap.Data[shardsKey])
+	if err != nil || shards < 1 {
+		return 1
+	}
+
+	return shards
+}
+
+func splitShards(compressed string, maxShardSize int) []string {
+	shards := []string{}
+	for len(compressed) > maxShardSize {
+		shards = append(shards, compressed[:maxShardSize])
+		compressed = compressed[maxShardSize:]
+	}
+
+	return append(shards, compressed)
+}
+
+func checksum(compressed string) string {
+	hash := sha256.Sum256([]byte(compressed))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,247 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// DefaultFlushInterval is the interval in which changed mappings are written to the backend
+var DefaultFlushInterval = time.Second * 5
+
+// Backend persists the recorded mappings
+type Backend interface {
+	// Load retrieves all previously saved mappings
+	Load(ctx context.Context) ([]synccontext.NameMapping, error)
+
+	// Save replaces all saved mappings with the given ones
+	Save(ctx context.Context, mappings []synccontext.NameMapping) error
+}
+
+// NewMemoryStore creates a new store that only keeps the mappings in memory
+func NewMemoryStore() *Store {
+	return NewStore(nil)
+}
+
+// NewStore creates a new store that persists its mappings into the given backend
+func NewStore(backend Backend) *Store {
+	return &Store{
+		backend: backend,
+
+		virtualToHost: map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName{},
+		hostToVirtual: map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName{},
+	}
+}
+
+var _ synccontext.MappingsStore = &Store{}
+
+type Store struct {
+	backend Backend
+
+	virtualToHost map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName
+	hostToVirtual map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName
+
+	dirty   bool
+	leading atomic.Bool
+	m       sync.RWMutex
+}
+
+// Load reads the mappings from the backend into the store and replaces the mappings that are currently known
+func (s *Store) Load(ctx context.Context) error {
+	if s.backend == nil {
+		return nil
+	}
+
+	mappings, err := s.backend.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.virtualToHost = map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName{}
+	s.hostToVirtual = map[schema.GroupVersionKind]map[types.NamespacedName]types.NamespacedName{}
+	for _, mapping := range mappings {
+		s.recordMapping(mapping.GroupVersionKind, mapping.Virtual, mapping.Host)
+	}
+	s.dirty = false
+
+	return nil
+}
+
+// StartRefresh periodically reloads the mappings from the backend until the context is done or Start is
+// called. This keeps the mappings of replicas that are not the leader up to date.
+func (s *Store) StartRefresh(ctx context.Context) {
+	if s.backend == nil {
+		return
+	}
+
+	go func() {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if s.leading.Load() {
+				return
+			}
+
+			err := s.Load(ctx)
+			if err != nil {
+				klog.FromContext(ctx).Error(err, "refresh mappings store")
+			}
+		}, DefaultFlushInterval)
+	}()
+}
+
+// Start periodically writes changed mappings to the backend until the context is done. Only the leader
+// is allowed to call this as it is the only one recording new mappings.
+func (s *Store) Start(ctx context.Context) {
+	if s.backend == nil {
+		return
+	}
+
+	s.leading.Store(true)
+	go func() {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			err := s.Flush(ctx)
+			if err != nil {
+				klog.FromContext(ctx).Error(err, "flush mappings store")
+			}
+		}, DefaultFlushInterval)
+	}()
+}
+
+// Flush writes the mappings to the backend if they have changed since the last flush
+func (s *Store) Flush(ctx context.Context) error {
+	if s.backend == nil {
+		return nil
+	}
+
+	s.m.Lock()
+	if !s.dirty {
+		s.m.Unlock()
+		return nil
+	}
+	mappings := s.list()
+	s.dirty = false
+	s.m.Unlock()
+
+	err := s.backend.Save(ctx, mappings)
+	if err != nil {
+		s.m.Lock()
+		s.dirty = true
+		s.m.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) VirtualToHostName(gvk schema.GroupVersionKind, vName types.NamespacedName) (types.NamespacedName, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	pName, ok := s.virtualToHost[gvk][vName]
+	return pName, ok
+}
+
+func (s *Store) HostToVirtualName(gvk schema.GroupVersionKind, pName types.NamespacedName) (types.NamespacedName, bool) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	vName, ok := s.hostToVirtual[gvk][pName]
+	return vName, ok
+}
+
+func (s *Store) RecordMapping(gvk schema.GroupVersionKind, vName, pName types.NamespacedName) {
+	if vName.Name == "" || pName.Name == "" {
+		return
+	}
+
+	// check if we already know about this mapping to avoid locking for writes
+	existing, ok := s.VirtualToHostName(gvk, vName)
+	if ok && existing == pName {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.recordMapping(gvk, vName, pName)
+	s.dirty = true
+}
+
+func (s *Store) recordMapping(gvk schema.GroupVersionKind, vName, pName types.NamespacedName) {
+	// remove an old mapping of the virtual object
+	if oldName, ok := s.virtualToHost[gvk][vName]; ok {
+		delete(s.hostToVirtual[gvk], oldName)
+	}
+
+	// remove an old mapping of the host object
+	if oldName, ok := s.hostToVirtual[gvk][pName]; ok {
+		delete(s.virtualToHost[gvk], oldName)
+	}
+
+	if s.virtualToHost[gvk] == nil {
+		s.virtualToHost[gvk] = map[types.NamespacedName]types.NamespacedName{}
+	}
+	if s.hostToVirtual[gvk] == nil {
+		s.hostToVirtual[gvk] = map[types.NamespacedName]types.NamespacedName{}
+	}
+
+	s.virtualToHost[gvk][vName] = pName
+	s.hostToVirtual[gvk][pName] = vName
+}
+
+func (s *Store) DeleteMapping(gvk schema.GroupVersionKind, vName types.NamespacedName) {
+	if _, ok := s.VirtualToHostName(gvk, vName); !ok {
+		return
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	pName, ok := s.virtualToHost[gvk][vName]
+	if !ok {
+		return
+	}
+
+	delete(s.virtualToHost[gvk], vName)
+	delete(s.hostToVirtual[gvk], pName)
+	s.dirty = true
+}
+
+func (s *Store) List() []synccontext.NameMapping {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.list()
+}
+
+func (s *Store) list() []synccontext.NameMapping {
+	mappings := []synccontext.NameMapping{}
+	for gvk, names := range s.virtualToHost {
+		for vName, pName := range names {
+			mappings = append(mappings, synccontext.NameMapping{
+				GroupVersionKind: gvk,
+				Virtual:          vName,
+				Host:             pName,
+			})
+		}
+	}
+
+	sort.Slice(mappings, func(i, j int) bool {
+		if mappings[i].GroupVersionKind.String() != mappings[j].GroupVersionKind.String() {
+			return mappings[i].GroupVersionKind.String() < mappings[j].GroupVersionKind.String()
+		}
+
+		return mappings[i].Virtual.String() < mappings[j].Virtual.String()
+	})
+	return mappings
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStore(t *testing.T) {
+	gvk := corev1.SchemeGroupVersion.WithKind("Secret")
+	vName := types.NamespacedName{Namespace: "test", Name: "a"}
+	pName := types.NamespacedName{Namespace: "vcluster", Name: "a-x-test-x-vcluster"}
+
+	kubeClient := fake.NewSimpleClientset()
+	backend := NewConfigMapBackend(kubeClient, "vcluster", "vcluster", nil)
+	store := NewStore(backend)
+	store.RecordMapping(gvk, vName, pName)
+
+	foundName, ok := store.VirtualToHostName(gvk, vName)
+	assert.Assert(t, ok)
+	assert.Equal(t, foundName, pName)
+	foundName, ok = store.HostToVirtualName(gvk, pName)
+	assert.Assert(t, ok)
+	assert.Equal(t, foundName, vName)
+
+	// make sure the mappings survive a restart
+	err := store.Flush(context.TODO())
+	assert.NilError(t, err)
+	restoredStore := NewStore(backend)
+	err = restoredStore.Load(context.TODO())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, restoredStore.List(), []synccontext.NameMapping{{GroupVersionKind: gvk, Virtual: vName, Host: pName}})
+
+	// remapping the virtual object should remove the old host mapping
+	newPName := types.NamespacedName{Namespace: "vcluster", Name: "b"}
+	restoredStore.RecordMapping(gvk, vName, newPName)
+	_, ok = restoredStore.HostToVirtualName(gvk, pName)
+	assert.Assert(t, !ok)
+
+	restoredStore.DeleteMapping(gvk, vName)
+	assert.Equal(t, len(restoredStore.List()), 0)
+}
+
+func TestConfigMapBackendShards(t *testing.T) {
+	defer func(maxShardSize int) { MaxShardSize = maxShardSize }(MaxShardSize)
+	MaxShardSize = 64
+
+	gvk := corev1.SchemeGroupVersion.WithKind("Secret")
+	mappings := []synccontext.NameMapping{}
+	for i := 0; i < 20; i++ {
+		mappings = append(mappings, synccontext.NameMapping{
+			GroupVersionKind: gvk,
+			Virtual:          types.NamespacedName{Namespace: "test", Name: fmt.Sprintf("secret-%d", i)},
+			Host:             types.NamespacedName{Namespace: "vcluster", Name: fmt.Sprintf("secret-%d-x-test-x-vcluster", i)},
+		})
+	}
+
+	ownerReferences := []metav1.OwnerReference{{APIVersion: "v1", Kind: "Service", Name: "vcluster", UID: "123"}}
+	kubeClient := fake.NewSimpleClientset()
+	backend := NewConfigMapBackend(kubeClient, "vcluster", "vcluster", ownerReferences)
+	err := backend.Save(context.TODO(), mappings)
+	assert.NilError(t, err)
+
+	configMaps, err := kubeClient.CoreV1().ConfigMaps("vcluster").List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, len(configMaps.Items) > 1)
+	shards := len(configMaps.Items)
+	for _, configMap := range configMaps.Items {
+		assert.DeepEqual(t, configMap.Labels, map[string]string{"app": "vcluster", "release": "vcluster"})
+		assert.DeepEqual(t, configMap.OwnerReferences, ownerReferences)
+	}
+
+	loaded, err := backend.Load(context.TODO())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loaded, mappings)
+
+	// shards that are not needed anymore are removed
+	err = backend.Save(context.TODO(), mappings[:1])
+	assert.NilError(t, err)
+	configMaps, err = kubeClient.CoreV1().ConfigMaps("vcluster").List(context.TODO(), metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Assert(t, len(configMaps.Items) < shards)
+	mainConfigMap, err := kubeClient.CoreV1().ConfigMaps("vcluster").Get(context.TODO(), ConfigMapName("vcluster"), metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, shardCount(mainConfigMap), len(configMaps.Items))
+
+	loaded, err = backend.Load(context.TODO())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loaded, mappings[:1])
+}
@@ -0,0 +1,242 @@
+package patcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HostDriftReason is the event reason used for drift events
+const HostDriftReason = "HostDrift"
+
+// NewDriftDetector creates a new drift detector that handles drift with the given conflict policy. The synced state
+// is kept in annotations on the host object, so drift is also detected after vCluster restarts.
+func NewDriftDetector(conflictPolicy config.ConflictPolicy, eventRecorder record.EventRecorder) synccontext.DriftDetector {
+	return &driftDetector{
+		conflictPolicy: conflictPolicy,
+		eventRecorder:  eventRecorder,
+	}
+}
+
+type driftDetector struct {
+	conflictPolicy config.ConflictPolicy
+	eventRecorder  record.EventRecorder
+}
+
+func (d *driftDetector) ConflictPolicy() config.ConflictPolicy {
+	return d.conflictPolicy
+}
+
+func (d *driftDetector) Changed(pObj, vObj client.Object) (bool, bool) {
+	// we can only detect changes if we synced this exact host object before
+	hostHash, ok := pObj.GetAnnotations()[translate.SyncedHostHashAnnotation]
+	if !ok {
+		return false, false
+	}
+
+	return hashObject(pObj) != hostHash, hashObject(vObj) != pObj.GetAnnotations()[translate.SyncedVirtualHashAnnotation]
+}
+
+func (d *driftDetector) HostKept(pObj client.Object) bool {
+	return pObj.GetAnnotations()[translate.SyncedHostKeptAnnotation] == "true"
+}
+
+func (d *driftDetector) Synced(ctx context.Context, pClient client.Client, pObj, vObj client.Object) error {
+	return d.persist(ctx, pClient, pObj, vObj, false)
+}
+
+func (d *driftDetector) KeptHost(ctx context.Context, pClient client.Client, pObj, vObj client.Object) error {
+	return d.persist(ctx, pClient, pObj, vObj, true)
+}
+
+// persist writes the hashes of both objects and if the host changes were kept to the host object
+func (d *driftDetector) persist(ctx context.Context, pClient client.Client, pObj, vObj client.Object, kept bool) error {
+	hostHash, virtualHash := hashObject(pObj), hashObject(vObj)
+	annotations := pObj.GetAnnotations()
+	if annotations[translate.SyncedHostHashAnnotation] == hostHash && annotations[translate.SyncedVirtualHashAnnotation] == virtualHash && d.HostKept(pObj) == kept {
+		return nil
+	}
+
+	// the hashes leave out these annotations, so writing them doesn't change the host hash
+	beforeObject := pObj.DeepCopyObject().(client.Object)
+	newAnnotations := map[string]string{}
+	for k, v := range annotations {
+		newAnnotations[k] = v
+	}
+	newAnnotations[translate.SyncedHostHashAnnotation] = hostHash
+	newAnnotations[translate.SyncedVirtualHashAnnotation] = virtualHash
+	if kept {
+		newAnnotations[translate.SyncedHostKeptAnnotation] = "true"
+	} else {
+		delete(newAnnotations, translate.SyncedHostKeptAnnotation)
+	}
+	pObj.SetAnnotations(newAnnotations)
+	return pClient.Patch(ctx, pObj, client.MergeFrom(beforeObject))
+}
+
+func (d *driftDetector) RecordDrift(vObj client.Object, eventType, message string) {
+	if d.eventRecorder == nil {
+		return
+	}
+
+	d.eventRecorder.Event(vObj, eventType, HostDriftReason, message)
+}
+
+// hashObject hashes the parts of an object a syncer could have changed. Status and
+// server managed metadata are left out, as they change without anyone touching the object.
+func hashObject(obj client.Object) string {
+	unstructuredObj, err := toUnstructured(obj)
+	if err != nil {
+		return ""
+	}
+
+	annotations := map[string]string{}
+	for k, v := range obj.GetAnnotations() {
+		if k == translate.SyncErrorAnnotation || k == translate.SyncErrorTimestampAnnotation || k == translate.SyncPausedAnnotation || k == translate.SyncedHostHashAnnotation || k == translate.SyncedVirtualHashAnnotation || k == translate.SyncedHostKeptAnnotation {
+			continue
+		}
+
+		annotations[k] = v
+	}
+
+	content := map[string]interface{}{}
+	for key, value := range unstructuredObj.Object {
+		if key == "apiVersion" || key == "kind" || key == "metadata" || key == "status" {
+			continue
+		}
+
+		content[key] = value
+	}
+	content["labels"] = obj.GetLabels()
+	content["annotations"] = annotations
+
+	out, err := json.Marshal(content)
+	if err != nil {
+		return ""
+	}
+
+	digest := sha256.Sum256(out)
+	return hex.EncodeToString(digest[:])
+}
+
+// changedFields returns the paths of the fields that differ between before and after, e.g. spec.ports
+func changedFields(before, after client.Object) ([]string, error) {
+	diff, err := client.MergeFrom(before).Data(after)
+	if err != nil {
+		return nil, err
+	}
+
+	patchDiff := map[string]interface{}{}
+	err = json.Unmarshal(diff, &patchDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	for key, value := range patchDiff {
+		if key == "status" {
+			continue
+		}
+
+		nested, ok := value.(map[string]interface{})
+		if !ok || len(nested) == 0 {
+			fields = append(fields, key)
+			continue
+		}
+
+		for nestedKey := range nested {
+			if key == "metadata" && nestedKey != "labels" && nestedKey != "annotations" {
+				continue
+			}
+
+			fields = append(fields, strings.Join([]string{key, nestedKey}, "."))
+		}
+	}
+
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// adoptIntoVirtual copies the given drifted host fields from hostBefore into the virtual object and keeps them in the
+// host object. Only fields the syncer copies unchanged from the virtual object can be adopted, all other fields are
+// reverted by the sync. Returns the adopted and the reverted fields.
+func adoptIntoVirtual(hostBefore, pObj, vObj client.Object, fields []string) ([]string, []string, error) {
+	hostBeforeUnstructured, err := toUnstructured(hostBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	hostUnstructured, err := toUnstructured(pObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	virtualUnstructured, err := toUnstructured(vObj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adopted, reverted := []string{}, []string{}
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		hostValue, hostFound, _ := unstructured.NestedFieldNoCopy(hostUnstructured.Object, path...)
+		virtualValue, virtualFound, _ := unstructured.NestedFieldNoCopy(virtualUnstructured.Object, path...)
+		if hostFound != virtualFound || !reflect.DeepEqual(hostValue, virtualValue) {
+			reverted = append(reverted, field)
+			continue
+		}
+
+		driftedValue, found, _ := unstructured.NestedFieldCopy(hostBeforeUnstructured.Object, path...)
+		if found {
+			err = unstructured.SetNestedField(hostUnstructured.Object, driftedValue, path...)
+			if err == nil {
+				err = unstructured.SetNestedField(virtualUnstructured.Object, runtime.DeepCopyJSONValue(driftedValue), path...)
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("adopt field %s: %w", field, err)
+			}
+		} else {
+			unstructured.RemoveNestedField(hostUnstructured.Object, path...)
+			unstructured.RemoveNestedField(virtualUnstructured.Object, path...)
+		}
+
+		adopted = append(adopted, field)
+	}
+	if len(adopted) == 0 {
+		return adopted, reverted, nil
+	}
+
+	err = fromUnstructured(hostUnstructured, pObj)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = fromUnstructured(virtualUnstructured, vObj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return adopted, reverted, nil
+}
+
+// fromUnstructured replaces the content of obj with the given unstructured object
+func fromUnstructured(unstructuredObj *unstructured.Unstructured, obj client.Object) error {
+	if target, ok := obj.(*unstructured.Unstructured); ok {
+		target.Object = unstructuredObj.Object
+		return nil
+	}
+
+	value := reflect.ValueOf(obj).Elem()
+	value.Set(reflect.Zero(value.Type()))
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.Object, obj)
+}
@@ -0,0 +1,123 @@
+package patcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestConflictPolicies(t *testing.T) {
+	testCases := []struct {
+		name           string
+		conflictPolicy config.ConflictPolicy
+		changeVirtual  bool
+
+		expectedHostData    string
+		expectedVirtualData string
+		expectedEvents      int
+	}{
+		{
+			name:             "revert",
+			conflictPolicy:   config.ConflictPolicyRevert,
+			expectedHostData: "virtual",
+			expectedEvents:   1,
+		},
+		{
+			name:             "ignore",
+			conflictPolicy:   config.ConflictPolicyIgnore,
+			expectedHostData: "host",
+		},
+		{
+			name:             "warn",
+			conflictPolicy:   config.ConflictPolicyWarn,
+			expectedHostData: "host",
+			expectedEvents:   1,
+		},
+		{
+			name:                "adopt into virtual",
+			conflictPolicy:      config.ConflictPolicyAdoptIntoVirtual,
+			expectedHostData:    "host",
+			expectedVirtualData: "host",
+			expectedEvents:      1,
+		},
+		{
+			name:             "adopt into virtual with changed virtual object",
+			conflictPolicy:   config.ConflictPolicyAdoptIntoVirtual,
+			changeVirtual:    true,
+			expectedHostData: "changed",
+			expectedEvents:   1,
+		},
+		{
+			name:             "ignore with changed virtual object",
+			conflictPolicy:   config.ConflictPolicyIgnore,
+			changeVirtual:    true,
+			expectedHostData: "changed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			vConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "virtual"},
+				Data:       map[string]string{"key": "virtual"},
+			}
+			pConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-x-default", Namespace: "test", UID: "host"},
+				Data:       map[string]string{"key": "virtual"},
+			}
+			pClient := testingutil.NewFakeClient(scheme.Scheme, pConfigMap.DeepCopy())
+			vClient := testingutil.NewFakeClient(scheme.Scheme, vConfigMap.DeepCopy())
+			eventRecorder := record.NewFakeRecorder(10)
+			ctx := &synccontext.SyncContext{
+				Context:        context.TODO(),
+				PhysicalClient: pClient,
+				VirtualClient:  vClient,
+				Drift:          NewDriftDetector(testCase.conflictPolicy, eventRecorder),
+			}
+
+			// remember the synced state and change the host object afterwards
+			assert.NilError(t, ctx.Drift.Synced(ctx, pClient, getConfigMap(t, pClient, pConfigMap), getConfigMap(t, vClient, vConfigMap)))
+			changedHost := getConfigMap(t, pClient, pConfigMap)
+			changedHost.Data["key"] = "host"
+			assert.NilError(t, pClient.Update(ctx, changedHost))
+			if testCase.changeVirtual {
+				changedVirtual := getConfigMap(t, vClient, vConfigMap)
+				changedVirtual.Data["key"] = "changed"
+				assert.NilError(t, vClient.Update(ctx, changedVirtual))
+			}
+
+			// the synced state is stored on the host object, so a new drift detector detects the change as well
+			ctx.Drift = NewDriftDetector(testCase.conflictPolicy, eventRecorder)
+
+			// sync the virtual data to the host, the second sync shouldn't report the same drift again
+			for i := 0; i < 2; i++ {
+				pObj, vObj := getConfigMap(t, pClient, pConfigMap), getConfigMap(t, vClient, vConfigMap)
+				syncerPatcher, err := NewSyncerPatcher(ctx, pObj, vObj)
+				assert.NilError(t, err)
+				pObj.Data = vObj.Data
+				assert.NilError(t, syncerPatcher.Patch(ctx, pObj, vObj))
+
+				assert.Equal(t, getConfigMap(t, pClient, pConfigMap).Data["key"], testCase.expectedHostData)
+				if testCase.expectedVirtualData != "" {
+					assert.Equal(t, getConfigMap(t, vClient, vConfigMap).Data["key"], testCase.expectedVirtualData)
+				}
+				assert.Equal(t, len(eventRecorder.Events), testCase.expectedEvents)
+			}
+		})
+	}
+}
+
+func getConfigMap(t *testing.T, kubeClient client.Client, configMap *corev1.ConfigMap) *corev1.ConfigMap {
+	retConfigMap := &corev1.ConfigMap{}
+	assert.NilError(t, kubeClient.Get(context.TODO(), client.ObjectKeyFromObject(configMap), retConfigMap))
+	return retConfigMap
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
+	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	"github.com/loft-sh/vcluster/pkg/util"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -65,19 +68,117 @@ type SyncerPatcher struct {
 
 // Patch will attempt to patch the given object, including its status.
 func (h *SyncerPatcher) Patch(ctx *synccontext.SyncContext, pObj, vObj client.Object) error {
+	keepHost := false
+	if ctx.Drift != nil && ctx.Drift.ConflictPolicy() != "" {
+		var err error
+		keepHost, err = h.handleDrift(ctx, pObj, vObj)
+		if err != nil {
+			return fmt.Errorf("handle host drift: %w", err)
+		}
+	}
+
 	err := h.vPatcher.Patch(ctx, vObj)
 	if err != nil {
-		return fmt.Errorf("patch virtual object: %w", err)
+		return patchError{fmt.Errorf("patch virtual object: %w", err)}
+	}
+
+	// keep the changes made on the host and remember them, so the same drift isn't reported again and
+	// we detect when the virtual object changes and the host object needs to be updated again
+	if keepHost {
+		err = ctx.Drift.KeptHost(ctx, h.pPatcher.client, h.pPatcher.beforeObject.DeepCopyObject().(client.Object), h.vPatcher.result(vObj))
+		if err != nil {
+			return patchError{fmt.Errorf("save kept host state: %w", err)}
+		}
+		return nil
 	}
 
 	err = h.pPatcher.Patch(ctx, pObj)
 	if err != nil {
-		return fmt.Errorf("patch host object: %w", err)
+		return patchError{fmt.Errorf("patch host object: %w", err)}
 	}
 
+	if ctx.Drift != nil {
+		err = ctx.Drift.Synced(ctx, h.pPatcher.client, h.pPatcher.result(pObj), h.vPatcher.result(vObj))
+		if err != nil {
+			return patchError{fmt.Errorf("save synced state: %w", err)}
+		}
+	}
 	return nil
 }
 
+// handleDrift checks if the host object was changed outside of vCluster since the last sync and the
+// sync would overwrite these changes. Returns true if the host object should not be patched.
+func (h *SyncerPatcher) handleDrift(ctx *synccontext.SyncContext, pObj, vObj client.Object) (bool, error) {
+	hostChanged, virtualChanged := ctx.Drift.Changed(h.pPatcher.beforeObject, h.vPatcher.beforeObject)
+	hostKept := ctx.Drift.HostKept(h.pPatcher.beforeObject)
+	if !hostChanged && !hostKept {
+		return false, nil
+	}
+
+	fields, err := changedFields(h.pPatcher.beforeObject, pObj)
+	if err != nil {
+		return false, err
+	} else if len(fields) == 0 {
+		return false, nil
+	}
+
+	changed := strings.Join(fields, ", ")
+	switch ctx.Drift.ConflictPolicy() {
+	case config.ConflictPolicyRevert:
+		ctx.Drift.RecordDrift(vObj, "Warning", fmt.Sprintf("Reverted changes made outside of vCluster to host fields: %s", changed))
+	case config.ConflictPolicyAdoptIntoVirtual:
+		// the virtual object changed as well, so its changes win
+		if virtualChanged {
+			ctx.Drift.RecordDrift(vObj, "Warning", fmt.Sprintf("Reverted changes made outside of vCluster to host fields, because the virtual object changed: %s", changed))
+			return false, nil
+		}
+
+		adopted, reverted, err := adoptIntoVirtual(h.pPatcher.beforeObject, pObj, vObj, fields)
+		if err != nil {
+			return false, err
+		}
+		if len(adopted) > 0 {
+			ctx.Drift.RecordDrift(vObj, "Normal", fmt.Sprintf("Adopted changes made outside of vCluster to host fields into the virtual object: %s", strings.Join(adopted, ", ")))
+		}
+		if len(reverted) > 0 {
+			ctx.Drift.RecordDrift(vObj, "Warning", fmt.Sprintf("Reverted changes made outside of vCluster to host fields that cannot be adopted: %s", strings.Join(reverted, ", ")))
+		}
+	case config.ConflictPolicyIgnore, config.ConflictPolicyWarn:
+		// the virtual object changed as well, so its changes win
+		if virtualChanged {
+			if ctx.Drift.ConflictPolicy() == config.ConflictPolicyWarn {
+				ctx.Drift.RecordDrift(vObj, "Warning", fmt.Sprintf("Overwrote changes made outside of vCluster to host fields, because the virtual object changed: %s", changed))
+			}
+
+			return false, nil
+		}
+
+		// changes that were kept before were already reported
+		if hostChanged && ctx.Drift.ConflictPolicy() == config.ConflictPolicyWarn {
+			ctx.Drift.RecordDrift(vObj, "Warning", fmt.Sprintf("Host fields were changed outside of vCluster and are kept: %s", changed))
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// patchError marks errors that happened while patching an object
+type patchError struct {
+	error
+}
+
+func (p patchError) Unwrap() error {
+	return p.error
+}
+
+// IsPatchError returns true if the error or one of the errors it wraps or aggregates happened while patching an object
+func IsPatchError(err error) bool {
+	return util.ContainsError(err, func(err error) bool {
+		return errors.As(err, &patchError{})
+	})
+}
+
 // Patcher is a utility for ensuring the proper patching of objects.
 type Patcher struct {
 	client       client.Client
@@ -87,6 +188,9 @@ type Patcher struct {
 	after        *unstructured.Unstructured
 	changes      map[string]bool
 
+	// patched is the object as returned by the server after the last patch
+	patched client.Object
+
 	direction string
 
 	NoStatusSubResource bool
@@ -185,7 +289,13 @@ func (h *Patcher) patchWholeObject(ctx context.Context, obj client.Object) error
 	}
 
 	logPatch(ctx, fmt.Sprintf("Apply %s patch", h.direction), obj, beforeObject, afterObject)
-	return h.client.Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	err = h.client.Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	if err != nil {
+		return err
+	}
+
+	h.patched = afterObject
+	return nil
 }
 
 // patch issues a patch for metadata and spec.
@@ -199,7 +309,13 @@ func (h *Patcher) patch(ctx context.Context, obj client.Object) error {
 	}
 
 	logPatch(ctx, fmt.Sprintf("Apply %s patch", h.direction), obj, beforeObject, afterObject)
-	return h.client.Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	err = h.client.Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	if err != nil {
+		return err
+	}
+
+	h.patched = afterObject
+	return nil
 }
 
 // patchStatus issues a patch if the status has changed.
@@ -213,7 +329,22 @@ func (h *Patcher) patchStatus(ctx context.Context, obj client.Object) error {
 	}
 
 	logPatch(ctx, fmt.Sprintf("Apply %s status patch", h.direction), obj, beforeObject, afterObject)
-	return h.client.Status().Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	err = h.client.Status().Patch(ctx, afterObject, client.MergeFrom(beforeObject))
+	if err != nil {
+		return err
+	}
+
+	h.patched = afterObject
+	return nil
+}
+
+// result returns the object as returned by the server after patching or the given object if nothing was patched
+func (h *Patcher) result(obj client.Object) client.Object {
+	if h.patched != nil {
+		return h.patched
+	}
+
+	return obj
 }
 
 func logPatch(ctx context.Context, patchMessage string, obj, beforeObject, afterObject client.Object) {
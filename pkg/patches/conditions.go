@@ -21,7 +21,8 @@ func ValidateAllConditions(obj *yaml.Node, match *yaml.Node, conditions []*confi
 }
 
 func ValidateCondition(obj *yaml.Node, match *yaml.Node, condition *config.PatchCondition) (bool, error) {
-	if condition == nil {
+	if condition == nil || condition.Expression != "" {
+		// expression conditions are evaluated before the patch is applied
 		return true, nil
 	}
 
@@ -0,0 +1,78 @@
+package patches
+
+import (
+	"github.com/loft-sh/vcluster/config"
+	patchesexpression "github.com/loft-sh/vcluster/pkg/patches/expression"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Expression sets the result of the patch expression at the patch path. If the expression returns null, the path is
+// removed instead.
+func Expression(obj1, obj2 *yaml.Node, patch *config.Patch) error {
+	matches, err := FindMatches(obj1, patch.Path)
+	if err != nil {
+		return errors.Wrap(err, "find matches")
+	}
+
+	if len(matches) == 0 {
+		validated, err := ValidateAllConditions(obj1, nil, patch.Conditions)
+		if err != nil {
+			return errors.Wrap(err, "validate conditions")
+		} else if !validated {
+			return nil
+		}
+
+		result, err := patchesexpression.Evaluate(patch.Expression, obj1, obj2, nil)
+		if err != nil {
+			return err
+		} else if result == nil {
+			return nil
+		}
+
+		value, err := NewNode(result)
+		if err != nil {
+			return errors.Wrap(err, "new node from expression result")
+		}
+
+		return createPath(obj1, patch.Path, value)
+	}
+
+	for _, m := range matches {
+		validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+		if err != nil {
+			return errors.Wrap(err, "validate conditions")
+		} else if !validated {
+			continue
+		}
+
+		result, err := patchesexpression.Evaluate(patch.Expression, obj1, obj2, m)
+		if err != nil {
+			return err
+		}
+
+		parent := Find(obj1, ContainsChild(m))
+		if parent == nil {
+			continue
+		} else if result == nil {
+			switch parent.Kind {
+			case yaml.MappingNode:
+				parent.Content = removeProperty(parent, m)
+			case yaml.SequenceNode:
+				parent.Content = removeChild(parent, m)
+			case yaml.DocumentNode, yaml.ScalarNode, yaml.AliasNode:
+			}
+
+			continue
+		}
+
+		value, err := NewNode(result)
+		if err != nil {
+			return errors.Wrap(err, "new node from expression result")
+		}
+
+		ReplaceNode(obj1, m, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package expression
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	// ObjectVariable is the CEL variable holding the patched object
+	ObjectVariable = "object"
+	// SourceObjectVariable is the CEL variable holding the object the patched object is synced from, which is null if
+	// there is none
+	SourceObjectVariable = "sourceObject"
+	// ValueVariable is the CEL variable holding the current value at the patch path, which is null if the path does not
+	// exist
+	ValueVariable = "value"
+
+	// expressionCostLimit limits the cost of a single expression evaluation, so a patch can't block the syncer
+	expressionCostLimit = 1000000
+)
+
+var (
+	expressionEnvOnce sync.Once
+	expressionEnv     *cel.Env
+	expressionEnvErr  error
+
+	programCacheMutex sync.Mutex
+	programCache      = map[string]cel.Program{}
+)
+
+func getExpressionEnv() (*cel.Env, error) {
+	expressionEnvOnce.Do(func() {
+		expressionEnv, expressionEnvErr = cel.NewEnv(
+			cel.Variable(ObjectVariable, cel.DynType),
+			cel.Variable(SourceObjectVariable, cel.DynType),
+			cel.Variable(ValueVariable, cel.DynType),
+			ext.Strings(),
+		)
+	})
+
+	return expressionEnv, expressionEnvErr
+}
+
+// Compile compiles the given CEL expression. If condition is true, the expression needs to return a bool.
+func Compile(expression string, condition bool) (cel.Program, error) {
+	env, err := getExpressionEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "create cel environment")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	} else if condition && ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression needs to return a bool, but returns %s", ast.OutputType())
+	}
+
+	return env.Program(ast, cel.CostLimit(expressionCostLimit))
+}
+
+func getProgram(expression string, condition bool) (cel.Program, error) {
+	programCacheMutex.Lock()
+	defer programCacheMutex.Unlock()
+
+	program, ok := programCache[expression]
+	if ok {
+		return program, nil
+	}
+
+	program, err := Compile(expression, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	programCache[expression] = program
+	return program, nil
+}
+
+// Evaluate evaluates the CEL expression against the given object, source object and value. Nil nodes are
+// passed as null.
+func Evaluate(expression string, obj, sourceObj, value *yaml.Node) (interface{}, error) {
+	program, err := getProgram(expression, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile expression")
+	}
+
+	return evaluateProgram(program, obj, sourceObj, value)
+}
+
+// EvaluateCondition evaluates the CEL condition expression against the given object and source object
+func EvaluateCondition(expression string, obj, sourceObj *yaml.Node) (bool, error) {
+	program, err := getProgram(expression, true)
+	if err != nil {
+		return false, errors.Wrap(err, "compile condition expression")
+	}
+
+	out, err := evaluateProgram(program, obj, sourceObj, nil)
+	if err != nil {
+		return false, err
+	}
+
+	matched, ok := out.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition expression returned %T instead of bool", out)
+	}
+
+	return matched, nil
+}
+
+func evaluateProgram(program cel.Program, obj, sourceObj, value *yaml.Node) (interface{}, error) {
+	vars := map[string]interface{}{}
+	for name, node := range map[string]*yaml.Node{ObjectVariable: obj, SourceObjectVariable: sourceObj, ValueVariable: value} {
+		var decoded interface{}
+		if node != nil {
+			err := node.Decode(&decoded)
+			if err != nil {
+				return nil, errors.Wrapf(err, "decode %s", name)
+			}
+		}
+
+		vars[name] = decoded
+	}
+
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return nil, errors.Wrap(err, "evaluate expression")
+	}
+
+	converted, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, errors.Wrap(err, "convert expression result")
+	}
+
+	return converted.(*structpb.Value).AsInterface(), nil
+}
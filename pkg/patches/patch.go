@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	vclusterconfig "github.com/loft-sh/vcluster/config"
+	patchesexpression "github.com/loft-sh/vcluster/pkg/patches/expression"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	jsonyaml "github.com/ghodss/yaml"
@@ -73,6 +74,20 @@ func ApplyPatches(destObj, sourceObj client.Object, patchesConf []*vclusterconfi
 }
 
 func applyPatch(obj1, obj2 *yaml.Node, patch *vclusterconfig.Patch, resolver NameResolver) error {
+	// expression conditions are evaluated once against the whole objects
+	for _, condition := range patch.Conditions {
+		if condition == nil || condition.Expression == "" {
+			continue
+		}
+
+		matched, err := patchesexpression.EvaluateCondition(condition.Expression, obj1, obj2)
+		if err != nil {
+			return errors.Wrap(err, "validate condition expression")
+		} else if !matched {
+			return nil
+		}
+	}
+
 	switch patch.Operation {
 	case vclusterconfig.PatchTypeRewriteName:
 		return RewriteName(obj1, patch, resolver)
@@ -90,6 +105,8 @@ func applyPatch(obj1, obj2 *yaml.Node, patch *vclusterconfig.Patch, resolver Nam
 		return Add(obj1, patch)
 	case vclusterconfig.PatchTypeCopyFromObject:
 		return CopyFromObject(obj1, obj2, patch)
+	case vclusterconfig.PatchTypeExpression:
+		return Expression(obj1, obj2, patch)
 	}
 
 	return fmt.Errorf("patch operation is missing or is not recognized (%s)", patch.Operation)
@@ -412,6 +412,117 @@ test2: {}`,
         - name: abc
         - name: def`,
 		},
+		{
+			name: "expression concatenates fields",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.host",
+				Expression: "object.metadata.name + '.' + sourceObject.spec.domain",
+			},
+			obj1: `metadata:
+    name: web
+spec:
+    port: 80`,
+			obj2: `spec:
+    domain: example.com`,
+			expected: `metadata:
+    name: web
+spec:
+    port: 80
+    host: web.example.com`,
+		},
+		{
+			name: "expression conditional default",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.replicas",
+				Expression: "value == null ? 1 : value",
+			},
+			obj1: `spec:
+    size: small`,
+			expected: `spec:
+    size: small
+    replicas: 1`,
+		},
+		{
+			name: "expression keeps existing value",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.replicas",
+				Expression: "value == null ? 1 : value",
+			},
+			obj1: `spec:
+    replicas: 3`,
+			expected: `spec:
+    replicas: 3`,
+		},
+		{
+			name: "expression removes path on null",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.debug",
+				Expression: "null",
+			},
+			obj1: `spec:
+    debug: true
+    size: small`,
+			expected: `spec:
+    size: small`,
+		},
+		{
+			name: "expression condition not matching",
+			patch: &config.Patch{
+				Operation: config.PatchTypeReplace,
+				Path:      "spec.size",
+				Value:     "large",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: "has(sourceObject.spec.tier) && sourceObject.spec.tier == 'gold'",
+					},
+				},
+			},
+			obj1: `spec:
+    size: small`,
+			obj2: `spec:
+    tier: silver`,
+			expected: `spec:
+    size: small`,
+		},
+		{
+			name: "expression condition matching",
+			patch: &config.Patch{
+				Operation: config.PatchTypeReplace,
+				Path:      "spec.size",
+				Value:     "large",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: "has(sourceObject.spec.tier) && sourceObject.spec.tier == 'gold'",
+					},
+				},
+			},
+			obj1: `spec:
+    size: small`,
+			obj2: `spec:
+    tier: gold`,
+			expected: `spec:
+    size: large`,
+		},
+		{
+			name: "expression condition returns no bool",
+			patch: &config.Patch{
+				Operation: config.PatchTypeReplace,
+				Path:      "spec.size",
+				Value:     "large",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: "object.spec.size",
+					},
+				},
+			},
+			obj1: `spec:
+    size: small`,
+			expectedErr: errors.New("condition expression returned string instead of bool"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/loft-sh/vcluster/pkg/controllers/importsync"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithReadOnlyImports denies changes to config maps and secrets that were imported from the host cluster
+func WithReadOnlyImports(handler http.Handler, registerCtx *synccontext.RegisterContext, uncachedVirtualClient client.Client) http.Handler {
+	s := serializer.NewCodecFactory(scheme.Scheme)
+	resources := map[string]importedResource{}
+	if registerCtx.Config.Sync.FromHost.ConfigMaps.Enabled {
+		resources["configmaps"] = importedResource{
+			newObject: func() client.Object { return &corev1.ConfigMap{} },
+			newList:   func() client.ObjectList { return &corev1.ConfigMapList{} },
+		}
+	}
+	if registerCtx.Config.Sync.FromHost.Secrets.Enabled {
+		resources["secrets"] = importedResource{
+			newObject: func() client.Object { return &corev1.Secret{} },
+			newList:   func() client.ObjectList { return &corev1.SecretList{} },
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, fmt.Errorf("request info is missing"))
+			return
+		}
+
+		resource, ok := resources[info.Resource]
+		if ok && info.IsResourceRequest && info.APIGroup == corev1.SchemeGroupVersion.Group {
+			var err error
+			if info.Name != "" && (info.Verb == "update" || info.Verb == "patch" || info.Verb == "delete") {
+				err = checkImportedObject(req, info, resource, uncachedVirtualClient)
+			} else if info.Verb == "deletecollection" {
+				err = checkImportedCollection(req, info, resource, uncachedVirtualClient)
+			}
+			if err != nil {
+				responsewriters.ErrorNegotiated(err, s, corev1.SchemeGroupVersion, w, req)
+				return
+			}
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+type importedResource struct {
+	newObject func() client.Object
+	newList   func() client.ObjectList
+}
+
+// checkImportedObject returns a forbidden error if the requested object was imported from the host cluster
+func checkImportedObject(req *http.Request, info *request.RequestInfo, resource importedResource, uncachedVirtualClient client.Client) error {
+	obj := resource.newObject()
+	err := uncachedVirtualClient.Get(req.Context(), types.NamespacedName{Namespace: info.Namespace, Name: info.Name}, obj)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	} else if obj.GetLabels()[importsync.ImportedLabel] != "true" {
+		return nil
+	}
+
+	return kerrors.NewForbidden(corev1.Resource(info.Resource), info.Name, fmt.Errorf("object is imported from host object %s and cannot be changed", obj.GetAnnotations()[importsync.ImportedFromAnnotation]))
+}
+
+// checkImportedCollection returns a forbidden error if a collection delete would remove an object that was imported
+// from the host cluster
+func checkImportedCollection(req *http.Request, info *request.RequestInfo, resource importedResource, uncachedVirtualClient client.Client) error {
+	query := req.URL.Query()
+	labelSelector, err := labels.Parse(query.Get("labelSelector"))
+	if err != nil {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err))
+	}
+	fieldSelector, err := fields.ParseSelector(query.Get("fieldSelector"))
+	if err != nil {
+		return kerrors.NewBadRequest(fmt.Sprintf("invalid field selector: %v", err))
+	}
+
+	// only look for imported objects the request would delete
+	importedRequirement, err := labels.NewRequirement(importsync.ImportedLabel, selection.Equals, []string{"true"})
+	if err != nil {
+		return err
+	}
+
+	list := resource.newList()
+	err = uncachedVirtualClient.List(req.Context(), list, &client.ListOptions{
+		Namespace:     info.Namespace,
+		LabelSelector: labelSelector.Add(*importedRequirement),
+		FieldSelector: fieldSelector,
+		Limit:         1,
+	})
+	if err != nil {
+		return err
+	} else if meta.LenList(list) == 0 {
+		return nil
+	}
+
+	return kerrors.NewForbidden(corev1.Resource(info.Resource), "", fmt.Errorf("collection contains objects imported from the host cluster that cannot be deleted, please exclude them with the label selector %s!=true", importsync.ImportedLabel))
+}
@@ -153,6 +153,9 @@ func NewServer(ctx *synccontext.ControllerContext, requestHeaderCaFile, clientCa
 	h = filters.WithServiceCreateRedirect(h, registerCtx, uncachedLocalClient, uncachedVirtualClient)
 	h = filters.WithRedirect(h, registerCtx, uncachedVirtualClient, admissionHandler, s.redirectResources)
 	h = filters.WithMetricsProxy(h, registerCtx)
+	if ctx.Config.Sync.FromHost.ConfigMaps.Enabled || ctx.Config.Sync.FromHost.Secrets.Enabled {
+		h = filters.WithReadOnlyImports(h, registerCtx, uncachedVirtualClient)
+	}
 
 	// inject apis
 	if ctx.Config.Sync.FromHost.Nodes.Enabled && ctx.Config.Sync.FromHost.Nodes.SyncBackChanges {
@@ -46,16 +46,9 @@ func InitAndValidateConfig(ctx context.Context, vConfig *config.VirtualClusterCo
 	}
 
 	// get workload target namespace
-	if vConfig.Experimental.MultiNamespaceMode.Enabled {
-		translate.Default = translate.NewMultiNamespaceTranslator(vConfig.WorkloadNamespace)
-	} else {
-		// ensure target namespace
-		vConfig.WorkloadTargetNamespace = vConfig.Experimental.SyncSettings.TargetNamespace
-		if vConfig.WorkloadTargetNamespace == "" {
-			vConfig.WorkloadTargetNamespace = vConfig.WorkloadNamespace
-		}
-
-		translate.Default = translate.NewSingleNamespaceTranslator(vConfig.WorkloadTargetNamespace)
+	err = translate.InitDefault(vConfig)
+	if err != nil {
+		return err
 	}
 
 	if err := EnsureBackingStoreChanges(
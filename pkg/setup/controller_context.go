@@ -3,18 +3,22 @@ package setup
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/nodes"
 	"github.com/loft-sh/vcluster/pkg/mappings"
+	"github.com/loft-sh/vcluster/pkg/mappings/store"
 	"github.com/loft-sh/vcluster/pkg/plugin"
 	"github.com/loft-sh/vcluster/pkg/pro"
 	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/syncer"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"github.com/loft-sh/vcluster/pkg/telemetry"
 	"github.com/loft-sh/vcluster/pkg/util/blockingcacheclient"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -64,11 +68,17 @@ func NewControllerContext(ctx context.Context, options *config.VirtualClusterCon
 		virtualManagerMetrics = options.Experimental.SyncSettings.VirtualMetricsBindAddress
 	}
 
+	// the changes planned by syncers in shadow mode are only served on the internal metrics listener
+	localManagerMetricsOptions := metricsserver.Options{BindAddress: localManagerMetrics}
+	if options.ShadowEnabled() {
+		localManagerMetricsOptions.ExtraHandlers = map[string]http.Handler{syncer.ShadowPath: syncer.ShadowHandler()}
+	}
+
 	// create physical manager
 	klog.Info("Using physical cluster at " + options.WorkloadConfig.Host)
 	localManager, err := NewLocalManager(options.WorkloadConfig, ctrl.Options{
 		Scheme:         scheme.Scheme,
-		Metrics:        metricsserver.Options{BindAddress: localManagerMetrics},
+		Metrics:        localManagerMetricsOptions,
 		LeaderElection: false,
 		Cache:          getLocalCacheOptions(options),
 		NewClient:      pro.NewPhysicalClient(options),
@@ -327,7 +337,7 @@ func initControllerContext(
 
 		WorkloadNamespaceClient: currentNamespaceClient,
 
-		Mappings: mappings.NewMappingsRegistry(),
+		Mappings: mappings.NewMappingsRegistry(store.NewStore(store.NewConfigMapBackend(vClusterOptions.WorkloadClient, vClusterOptions.WorkloadNamespace, vClusterOptions.Name, translate.GetOwnerReference(nil)))),
 
 		StopChan: stopChan,
 		Config:   vClusterOptions,
@@ -10,6 +10,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/controllers"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/services"
 	"github.com/loft-sh/vcluster/pkg/coredns"
+	"github.com/loft-sh/vcluster/pkg/mappings/store"
 	"github.com/loft-sh/vcluster/pkg/plugin"
 	"github.com/loft-sh/vcluster/pkg/pro"
 	"github.com/loft-sh/vcluster/pkg/specialservices"
@@ -101,6 +102,13 @@ func StartControllers(controllerContext *synccontext.ControllerContext, syncers
 
 	// if not noop syncer
 	if !controllerContext.Config.Experimental.SyncSettings.DisableSync {
+		// reload the persisted name mappings and start saving them, we do this here
+		// as only the leader is allowed to record new mappings
+		err = StartMappingsStore(controllerContext)
+		if err != nil {
+			return errors.Wrap(err, "start mappings store")
+		}
+
 		// make sure the kubernetes service is synced
 		err = SyncKubernetesService(controllerContext)
 		if err != nil {
@@ -147,6 +155,37 @@ func StartControllers(controllerContext *synccontext.ControllerContext, syncers
 	return nil
 }
 
+// LoadMappingsStore loads the persisted name mappings and keeps them up to date on every replica
+func LoadMappingsStore(ctx *synccontext.ControllerContext) error {
+	mappingsStore, ok := ctx.Mappings.Store().(*store.Store)
+	if !ok {
+		return nil
+	}
+
+	err := mappingsStore.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	mappingsStore.StartRefresh(ctx)
+	return nil
+}
+
+func StartMappingsStore(ctx *synccontext.ControllerContext) error {
+	mappingsStore, ok := ctx.Mappings.Store().(*store.Store)
+	if !ok {
+		return nil
+	}
+
+	err := mappingsStore.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	mappingsStore.Start(ctx)
+	return nil
+}
+
 func ApplyCoreDNS(controllerContext *synccontext.ControllerContext) {
 	_ = wait.ExponentialBackoffWithContext(controllerContext.Context, wait.Backoff{Duration: time.Second, Factor: 1.5, Cap: time.Minute, Steps: math.MaxInt32}, func(ctx context.Context) (bool, error) {
 		err := coredns.ApplyManifest(ctx, controllerContext.Config.ControlPlane.Advanced.DefaultImageRegistry, controllerContext.VirtualManager.GetConfig(), controllerContext.VirtualClusterVersion)
@@ -2,9 +2,9 @@ package syncer
 
 import (
 	"context"
+	"time"
 
 	"github.com/loft-sh/vcluster/pkg/config"
-	"github.com/loft-sh/vcluster/pkg/constants"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
@@ -13,7 +13,6 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	controller2 "sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
 func RegisterFakeSyncer(ctx *synccontext.RegisterContext, syncer syncertypes.FakeSyncer) error {
@@ -51,7 +50,13 @@ type fakeSyncer struct {
 	virtualClient client.Client
 }
 
-func (r *fakeSyncer) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *fakeSyncer) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	// record reconcile metrics
+	start := time.Now()
+	defer func() {
+		observeReconcile(r.syncer.Name(), OperationSync, start, result, err)
+	}()
+
 	log := loghelper.NewFromExisting(r.log.Base(), req.Name)
 	syncContext := &synccontext.SyncContext{
 		Context:                ctx,
@@ -76,7 +81,7 @@ func (r *fakeSyncer) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// get virtual object
 	vObj := r.syncer.Resource()
-	err := r.virtualClient.Get(ctx, req.NamespacedName, vObj)
+	err = r.virtualClient.Get(ctx, req.NamespacedName, vObj)
 	if err != nil {
 		if !kerrors.IsNotFound(err) {
 			return ctrl.Result{}, err
@@ -96,10 +101,7 @@ func (r *fakeSyncer) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 func (r *fakeSyncer) Register(ctx *synccontext.RegisterContext) error {
 	controller := ctrl.NewControllerManagedBy(ctx.VirtualManager).
-		WithOptions(controller2.Options{
-			MaxConcurrentReconciles: 10,
-			CacheSyncTimeout:        constants.DefaultCacheSyncTimeout,
-		}).
+		WithOptions(ctx.ControllerOptions(r.syncer.Name())).
 		Named(r.syncer.Name()).
 		For(r.syncer.Resource())
 	var err error
@@ -0,0 +1,86 @@
+package syncer
+
+import (
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/syncer/translator"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Queue depth and time in queue are already exposed by controller-runtime as workqueue_depth and
+// workqueue_queue_duration_seconds, labeled with the syncer name.
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeRequeue = "requeue"
+	OutcomeError   = "error"
+
+	OperationGet       = "get"
+	OperationSync      = "sync"
+	OperationTranslate = "translate"
+	OperationPatch     = "patch"
+
+	DirectionVirtual = "virtual"
+	DirectionHost    = "host"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "vcluster_syncer_reconcile_total",
+		Help: "Total number of reconciliations per syncer and outcome",
+	}, []string{"syncer", "outcome"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vcluster_syncer_reconcile_duration_seconds",
+		Help:    "Duration of reconciliations per syncer",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+	}, []string{"syncer"})
+
+	errorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "vcluster_syncer_errors_total",
+		Help: "Total number of reconcile errors per syncer and failed operation",
+	}, []string{"syncer", "operation"})
+
+	eventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "vcluster_syncer_events_total",
+		Help: "Total number of watch events per syncer and the cluster they originated from",
+	}, []string{"syncer", "direction"})
+
+	pausedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vcluster_syncer_paused_objects",
+		Help: "Number of virtual objects per syncer whose sync is currently paused",
+	}, []string{"syncer"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileTotal, reconcileDuration, errorsTotal, eventsTotal, pausedObjects)
+}
+
+func observeReconcile(syncerName, operation string, start time.Time, result ctrl.Result, err error) {
+	reconcileDuration.WithLabelValues(syncerName).Observe(time.Since(start).Seconds())
+	if err != nil {
+		if patcher.IsPatchError(err) {
+			operation = OperationPatch
+		} else if translator.IsTranslateError(err) {
+			operation = OperationTranslate
+		}
+
+		reconcileTotal.WithLabelValues(syncerName, OutcomeError).Inc()
+		errorsTotal.WithLabelValues(syncerName, operation).Inc()
+	} else if result.Requeue || result.RequeueAfter > 0 {
+		reconcileTotal.WithLabelValues(syncerName, OutcomeRequeue).Inc()
+	} else {
+		reconcileTotal.WithLabelValues(syncerName, OutcomeSuccess).Inc()
+	}
+}
+
+func observeEvent(syncerName, direction string) {
+	eventsTotal.WithLabelValues(syncerName, direction).Inc()
+}
+
+func observePaused(syncerName string, paused int) {
+	pausedObjects.WithLabelValues(syncerName).Set(float64(paused))
+}
@@ -0,0 +1,113 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
+	syncertesting "github.com/loft-sh/vcluster/pkg/syncer/testing"
+	"github.com/loft-sh/vcluster/pkg/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"github.com/moby/locker"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestErrorMetrics(t *testing.T) {
+	ctx := context.Background()
+	vSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a",
+			Namespace: namespaceInVclusterA,
+			UID:       "123",
+		},
+	}
+
+	testCases := []struct {
+		name string
+		err  func(t *testing.T) error
+
+		expectedOperation string
+	}{
+		{
+			name: "sync error",
+			err: func(_ *testing.T) error {
+				return errors.New("denied by admission webhook")
+			},
+			expectedOperation: OperationSync,
+		},
+		{
+			name: "aggregated translate error",
+			err: func(_ *testing.T) error {
+				return utilerrors.NewAggregate([]error{translator.NewTranslateError(errors.New("invalid field"))})
+			},
+			expectedOperation: OperationTranslate,
+		},
+		{
+			name: "aggregated patch error",
+			err: func(t *testing.T) error {
+				// patching a host object that doesn't exist fails
+				pObj := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "test"}}
+				vObj := vSecret.DeepCopy()
+				syncContext := &synccontext.SyncContext{
+					Context:        ctx,
+					PhysicalClient: testingutil.NewFakeClient(scheme.Scheme),
+					VirtualClient:  testingutil.NewFakeClient(scheme.Scheme, vObj.DeepCopy()),
+				}
+				syncerPatcher, err := patcher.NewSyncerPatcher(syncContext, pObj, vObj)
+				assert.NilError(t, err)
+				pObj.Data = map[string][]byte{"key": []byte("value")}
+				patchErr := syncerPatcher.Patch(syncContext, pObj, vObj)
+				assert.Assert(t, patchErr != nil)
+				return utilerrors.NewAggregate([]error{errors.New("sync failed"), patchErr})
+			},
+			expectedOperation: OperationPatch,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pClient := testingutil.NewFakeClient(scheme.Scheme)
+			vClient := testingutil.NewFakeClient(scheme.Scheme, vSecret.DeepCopy())
+			fakeContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+			syncerImpl, err := NewMockSyncer(fakeContext)
+			assert.NilError(t, err)
+			syncer := &failingSyncer{mockSyncer: syncerImpl.(*mockSyncer), err: testCase.err(t)}
+			controller := &SyncController{
+				syncer: syncer,
+
+				genericSyncer: syncer.Syncer(),
+
+				log:            loghelper.New(syncer.Name()),
+				vEventRecorder: record.NewFakeRecorder(10),
+				physicalClient: pClient,
+
+				currentNamespace:       fakeContext.CurrentNamespace,
+				currentNamespaceClient: fakeContext.CurrentNamespaceClient,
+
+				mappings: fakeContext.Mappings,
+
+				virtualClient: vClient,
+				options:       &syncertypes.Options{},
+
+				locker: locker.New(),
+			}
+
+			errorsBefore := testutil.ToFloat64(errorsTotal.WithLabelValues(syncer.Name(), testCase.expectedOperation))
+			_, err = controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "a", Namespace: namespaceInVclusterA}})
+			assert.Assert(t, err != nil)
+			assert.Equal(t, testutil.ToFloat64(errorsTotal.WithLabelValues(syncer.Name(), testCase.expectedOperation)), errorsBefore+1)
+		})
+	}
+}
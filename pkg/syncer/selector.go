@@ -0,0 +1,67 @@
+package syncer
+
+import (
+	"path/filepath"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/config"
+	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newSelectorExcluder returns an excluder for virtual objects that do not match the configured selector
+// or nil if no selector is configured
+func newSelectorExcluder(selector vclusterconfig.SyncControllerSelector) (syncertypes.ObjectExcluder, error) {
+	if len(selector.Labels) == 0 && len(selector.MatchExpressions) == 0 && len(selector.Namespaces) == 0 && len(selector.ExcludedNamespaces) == 0 {
+		return nil, nil
+	}
+
+	labelSelector, err := config.SyncControllerLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &selectorExcluder{
+		labelSelector:      labelSelector,
+		namespaces:         selector.Namespaces,
+		excludedNamespaces: selector.ExcludedNamespaces,
+	}, nil
+}
+
+type selectorExcluder struct {
+	labelSelector      labels.Selector
+	namespaces         []string
+	excludedNamespaces []string
+}
+
+func (s *selectorExcluder) ExcludeVirtual(vObj client.Object) bool {
+	if !s.labelSelector.Matches(labels.Set(vObj.GetLabels())) {
+		return true
+	}
+
+	// namespace patterns only apply to namespaced objects
+	namespace := vObj.GetNamespace()
+	if namespace == "" {
+		return false
+	}
+	if len(s.namespaces) > 0 && !matchesAny(s.namespaces, namespace) {
+		return true
+	}
+
+	return matchesAny(s.excludedNamespaces, namespace)
+}
+
+func (s *selectorExcluder) ExcludePhysical(_ client.Object) bool {
+	return false
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,239 @@
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxPlannedChanges is the number of planned changes kept in memory
+const maxPlannedChanges = 1000
+
+// ShadowPath is the path the changes planned by syncers in shadow mode are served at on the host metrics listener
+const ShadowPath = "/debug/vcluster/shadow"
+
+// redactedValue replaces secret values in planned changes
+const redactedValue = "REDACTED"
+
+// PlannedChange is a change a syncer in shadow mode would have made
+type PlannedChange struct {
+	// Time is the last time the change was planned
+	Time time.Time `json:"time"`
+
+	// Count is how often the same change was planned
+	Count int `json:"count"`
+
+	Syncer    string `json:"syncer"`
+	Direction string `json:"direction"`
+	Operation string `json:"operation"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Diff is the patch that would have been applied or the whole object for creates and updates
+	Diff string `json:"diff,omitempty"`
+}
+
+var plannedChanges = &plannedChangesBuffer{}
+
+type plannedChangesBuffer struct {
+	changes []PlannedChange
+	m       sync.Mutex
+}
+
+func (p *plannedChangesBuffer) add(change PlannedChange) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	// the same change is planned on every reconcile, so only count it
+	for i := range p.changes {
+		existing := &p.changes[i]
+		if existing.Syncer == change.Syncer && existing.Direction == change.Direction && existing.Operation == change.Operation && existing.Kind == change.Kind && existing.Namespace == change.Namespace && existing.Name == change.Name && existing.Diff == change.Diff {
+			existing.Time = change.Time
+			existing.Count++
+			return
+		}
+	}
+
+	change.Count = 1
+	p.changes = append(p.changes, change)
+	if len(p.changes) > maxPlannedChanges {
+		p.changes = p.changes[len(p.changes)-maxPlannedChanges:]
+	}
+}
+
+func (p *plannedChangesBuffer) list(syncerName string) []PlannedChange {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	changes := []PlannedChange{}
+	for _, change := range p.changes {
+		if syncerName != "" && change.Syncer != syncerName {
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// ShadowHandler returns the changes planned by syncers in shadow mode as json. The
+// syncer query parameter can be used to only return the changes of a single syncer.
+func ShadowHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		out, err := json.MarshalIndent(plannedChanges.list(req.URL.Query().Get("syncer")), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	})
+}
+
+// newShadowClient returns a client that only records and validates changes through a server side
+// dry run instead of writing them
+func newShadowClient(syncerName, direction string, kubeClient client.Client, log loghelper.Logger) client.Client {
+	return &shadowClient{
+		Client: client.NewDryRunClient(kubeClient),
+
+		syncerName: syncerName,
+		direction:  direction,
+		log:        log,
+	}
+}
+
+type shadowClient struct {
+	client.Client
+
+	syncerName string
+	direction  string
+	log        loghelper.Logger
+}
+
+func (s *shadowClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	s.record("create", obj, objectDiff(obj))
+	return s.Client.Create(ctx, obj, opts...)
+}
+
+func (s *shadowClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	s.record("update", obj, objectDiff(obj))
+	return s.Client.Update(ctx, obj, opts...)
+}
+
+func (s *shadowClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	s.record("patch", obj, patchDiff(obj, patch))
+	return s.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (s *shadowClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	s.record("delete", obj, "")
+	return s.Client.Delete(ctx, obj, opts...)
+}
+
+func (s *shadowClient) Status() client.SubResourceWriter {
+	return &shadowStatusWriter{
+		SubResourceWriter: s.Client.Status(),
+
+		shadowClient: s,
+	}
+}
+
+func (s *shadowClient) record(operation string, obj client.Object, diff string) {
+	kind := ""
+	gvk, err := s.Client.GroupVersionKindFor(obj)
+	if err == nil {
+		kind = gvk.Kind
+	}
+	if kind == "Secret" {
+		diff = redactSecretDiff(diff)
+	}
+
+	s.log.Infof("shadow mode: would %s %s %s %s/%s: %s", operation, s.direction, kind, obj.GetNamespace(), obj.GetName(), diff)
+	plannedChanges.add(PlannedChange{
+		Time:      time.Now(),
+		Syncer:    s.syncerName,
+		Direction: s.direction,
+		Operation: operation,
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Diff:      diff,
+	})
+}
+
+type shadowStatusWriter struct {
+	client.SubResourceWriter
+
+	shadowClient *shadowClient
+}
+
+func (s *shadowStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	s.shadowClient.record("update status", obj, objectDiff(obj))
+	return s.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (s *shadowStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	s.shadowClient.record("patch status", obj, patchDiff(obj, patch))
+	return s.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func objectDiff(obj client.Object) string {
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.SetManagedFields(nil)
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
+
+func patchDiff(obj client.Object, patch client.Patch) string {
+	out, err := patch.Data(obj)
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
+
+// redactSecretDiff replaces the values of data and stringData in a secret diff. Diffs that are
+// not a json object (e.g. json patches) are redacted completely.
+func redactSecretDiff(diff string) string {
+	if diff == "" {
+		return diff
+	}
+
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(diff), &obj); err != nil {
+		return redactedValue
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for key, value := range values {
+			if value != nil {
+				values[key] = redactedValue
+			}
+		}
+	}
+
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(out)
+}
@@ -0,0 +1,92 @@
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestShadowClient(t *testing.T) {
+	plannedChanges = &plannedChangesBuffer{}
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "test"},
+		Data:       map[string]string{"key": "old"},
+	}
+	fakeClient := testingutil.NewFakeClient(scheme.Scheme, existing.DeepCopy())
+	shadowClient := newShadowClient("configmap", DirectionHost, fakeClient, loghelper.New("test"))
+
+	// creating twice should only be recorded once and nothing should be written
+	for i := 0; i < 2; i++ {
+		created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "test"}}
+		assert.NilError(t, shadowClient.Create(context.TODO(), created))
+	}
+	err := fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "test", Name: "created"}, &corev1.ConfigMap{})
+	assert.Assert(t, kerrors.IsNotFound(err))
+
+	// patches should be recorded with their diff
+	patched := &corev1.ConfigMap{}
+	assert.NilError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(existing), patched))
+	before := patched.DeepCopy()
+	patched.Data["key"] = "new"
+	assert.NilError(t, shadowClient.Patch(context.TODO(), patched, client.MergeFrom(before)))
+	assert.NilError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(existing), patched))
+	assert.Equal(t, patched.Data["key"], "old")
+
+	// the planned changes are exposed through the handler
+	recorder := httptest.NewRecorder()
+	ShadowHandler().ServeHTTP(recorder, httptest.NewRequest("GET", "/?syncer=configmap", nil))
+	changes := []PlannedChange{}
+	assert.NilError(t, json.Unmarshal(recorder.Body.Bytes(), &changes))
+	assert.Equal(t, len(changes), 2)
+	assert.Equal(t, changes[0].Operation, "create")
+	assert.Equal(t, changes[0].Count, 2)
+	assert.Equal(t, changes[1].Operation, "patch")
+	assert.Equal(t, changes[1].Diff, `{"data":{"key":"new"}}`)
+
+	recorder = httptest.NewRecorder()
+	ShadowHandler().ServeHTTP(recorder, httptest.NewRequest("GET", "/?syncer=other", nil))
+	assert.Equal(t, recorder.Body.String(), "[]")
+}
+
+func TestShadowClientRedactsSecrets(t *testing.T) {
+	plannedChanges = &plannedChangesBuffer{}
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "test"},
+		Data:       map[string][]byte{"password": []byte("old")},
+	}
+	fakeClient := testingutil.NewFakeClient(scheme.Scheme, existing.DeepCopy())
+	shadowClient := newShadowClient("secret", DirectionHost, fakeClient, loghelper.New("test"))
+
+	created := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "test"},
+		StringData: map[string]string{"token": "secret-token"},
+	}
+	assert.NilError(t, shadowClient.Create(context.TODO(), created))
+
+	patched := &corev1.Secret{}
+	assert.NilError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(existing), patched))
+	before := patched.DeepCopy()
+	patched.Data["password"] = []byte("new")
+	patched.Data["removed"] = nil
+	assert.NilError(t, shadowClient.Patch(context.TODO(), patched, client.MergeFrom(before)))
+	assert.NilError(t, shadowClient.Patch(context.TODO(), patched, client.RawPatch(types.JSONPatchType, []byte(`[{"op":"replace","path":"/data/password","value":"bmV3"}]`))))
+
+	changes := plannedChanges.list("secret")
+	assert.Equal(t, len(changes), 3)
+	assert.Assert(t, !strings.Contains(changes[0].Diff, "secret-token"))
+	assert.Assert(t, strings.Contains(changes[0].Diff, `"stringData":{"token":"REDACTED"}`))
+	assert.Equal(t, changes[1].Diff, `{"data":{"password":"REDACTED","removed":null}}`)
+	assert.Equal(t, changes[2].Diff, "REDACTED")
+}
@@ -50,6 +50,9 @@ type SyncContext struct {
 
 	Mappings MappingsRegistry
 
+	// Drift detects host objects that were changed outside of vCluster, can be nil
+	Drift DriftDetector
+
 	CurrentNamespace       string
 	CurrentNamespaceClient client.Client
 }
@@ -0,0 +1,57 @@
+package synccontext
+
+import (
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	defaultWorkers   = 10
+	defaultBaseDelay = 5 * time.Millisecond
+	defaultMaxDelay  = 1000 * time.Second
+	defaultQPS       = 10
+	defaultBurst     = 100
+)
+
+// ControllerOptions returns the controller options for the syncer with the given name. Custom
+// syncers and plugins should use these options to respect the configured workers and rate limits.
+func (r *RegisterContext) ControllerOptions(name string) controller.Options {
+	workers := defaultWorkers
+	baseDelay, maxDelay := defaultBaseDelay, defaultMaxDelay
+	qps, burst := defaultQPS, defaultBurst
+	if r.Config != nil {
+		settings := r.Config.SyncController(name)
+		if settings.Workers > 0 {
+			workers = settings.Workers
+		}
+		if settings.RateLimiter.BaseDelay != "" {
+			if parsed, err := time.ParseDuration(settings.RateLimiter.BaseDelay); err == nil {
+				baseDelay = parsed
+			}
+		}
+		if settings.RateLimiter.MaxDelay != "" {
+			if parsed, err := time.ParseDuration(settings.RateLimiter.MaxDelay); err == nil {
+				maxDelay = parsed
+			}
+		}
+		if settings.RateLimiter.QPS > 0 {
+			qps = settings.RateLimiter.QPS
+		}
+		if settings.RateLimiter.Burst > 0 {
+			burst = settings.RateLimiter.Burst
+		}
+	}
+
+	return controller.Options{
+		MaxConcurrentReconciles: workers,
+		CacheSyncTimeout:        constants.DefaultCacheSyncTimeout,
+		RateLimiter: workqueue.NewMaxOfRateLimiter(
+			workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(qps), burst)},
+		),
+	}
+}
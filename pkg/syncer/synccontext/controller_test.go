@@ -0,0 +1,123 @@
+package synccontext
+
+import (
+	"testing"
+	"time"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"gotest.tools/v3/assert"
+)
+
+func TestControllerOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		controllers *vclusterconfig.SyncControllers
+
+		expectedWorkers   int
+		expectedBaseDelay time.Duration
+		expectedMaxDelay  time.Duration
+		// expectedBucketDelay is the minimum delay of an object that exceeds the burst
+		expectedBucketDelay time.Duration
+	}{
+		{
+			name:              "No config",
+			expectedWorkers:   defaultWorkers,
+			expectedBaseDelay: defaultBaseDelay,
+			expectedMaxDelay:  defaultMaxDelay,
+		},
+		{
+			name: "Default",
+			controllers: &vclusterconfig.SyncControllers{
+				Default: vclusterconfig.SyncController{
+					Workers: 20,
+					RateLimiter: vclusterconfig.SyncControllerRateLimiter{
+						BaseDelay: "10ms",
+						MaxDelay:  "1m",
+					},
+				},
+			},
+			expectedWorkers:   20,
+			expectedBaseDelay: 10 * time.Millisecond,
+			expectedMaxDelay:  time.Minute,
+		},
+		{
+			name: "Override",
+			controllers: &vclusterconfig.SyncControllers{
+				Default: vclusterconfig.SyncController{
+					Workers: 20,
+					RateLimiter: vclusterconfig.SyncControllerRateLimiter{
+						BaseDelay: "10ms",
+					},
+				},
+				Overrides: map[string]vclusterconfig.SyncController{
+					"pod": {
+						Workers: 50,
+						RateLimiter: vclusterconfig.SyncControllerRateLimiter{
+							MaxDelay: "2m",
+							QPS:      1,
+							Burst:    1,
+						},
+					},
+				},
+			},
+			expectedWorkers:     50,
+			expectedBaseDelay:   10 * time.Millisecond,
+			expectedMaxDelay:    2 * time.Minute,
+			expectedBucketDelay: 500 * time.Millisecond,
+		},
+		{
+			name: "Override of another syncer",
+			controllers: &vclusterconfig.SyncControllers{
+				Overrides: map[string]vclusterconfig.SyncController{
+					"ingressclass": {
+						Workers: 1,
+					},
+				},
+			},
+			expectedWorkers:   defaultWorkers,
+			expectedBaseDelay: defaultBaseDelay,
+			expectedMaxDelay:  defaultMaxDelay,
+		},
+		{
+			name: "Invalid durations",
+			controllers: &vclusterconfig.SyncControllers{
+				Default: vclusterconfig.SyncController{
+					RateLimiter: vclusterconfig.SyncControllerRateLimiter{
+						BaseDelay: "invalid",
+						MaxDelay:  "invalid",
+					},
+				},
+			},
+			expectedWorkers:   defaultWorkers,
+			expectedBaseDelay: defaultBaseDelay,
+			expectedMaxDelay:  defaultMaxDelay,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			registerContext := &RegisterContext{}
+			if testCase.controllers != nil {
+				vConfig := &config.VirtualClusterConfig{}
+				vConfig.Sync.Controllers = *testCase.controllers
+				registerContext.Config = vConfig
+			}
+
+			options := registerContext.ControllerOptions("pod")
+			assert.Equal(t, options.MaxConcurrentReconciles, testCase.expectedWorkers)
+
+			// the first retry uses the base delay and retries back off up to the max delay
+			assert.Equal(t, options.RateLimiter.When("a"), testCase.expectedBaseDelay)
+			for i := 0; i < 40; i++ {
+				options.RateLimiter.When("a")
+			}
+			assert.Equal(t, options.RateLimiter.When("a"), testCase.expectedMaxDelay)
+
+			// objects above the burst have to wait for the bucket
+			if testCase.expectedBucketDelay > 0 {
+				assert.Assert(t, options.RateLimiter.When("b") >= testCase.expectedBucketDelay)
+			}
+		})
+	}
+}
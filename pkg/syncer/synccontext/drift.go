@@ -0,0 +1,30 @@
+package synccontext
+
+import (
+	"context"
+
+	"github.com/loft-sh/vcluster/config"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DriftDetector remembers the state of synced objects on the host object to detect host objects
+// that were changed outside of vCluster since the last sync
+type DriftDetector interface {
+	// ConflictPolicy returns how drifted host objects should be handled
+	ConflictPolicy() config.ConflictPolicy
+
+	// Changed returns if the host or virtual object changed since they were last synced
+	Changed(pObj, vObj client.Object) (hostChanged bool, virtualChanged bool)
+
+	// HostKept returns if the changes made to the host object outside of vCluster were kept by the last sync
+	HostKept(pObj client.Object) bool
+
+	// Synced persists the state of both objects after a successful sync on the host object
+	Synced(ctx context.Context, pClient client.Client, pObj, vObj client.Object) error
+
+	// KeptHost persists the state of both objects on the host object after its changes were kept instead of synced
+	KeptHost(ctx context.Context, pClient client.Client, pObj, vObj client.Object) error
+
+	// RecordDrift records a drift event on the virtual object
+	RecordDrift(vObj client.Object, eventType, message string)
+}
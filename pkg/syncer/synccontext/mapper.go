@@ -16,6 +16,39 @@ type MappingsRegistry interface {
 
 	// AddMapper adds the given mapper to the store.
 	AddMapper(mapper Mapper) error
+
+	// List returns all registered mappers.
+	List() []Mapper
+
+	// Store returns the persisted name mappings store.
+	Store() MappingsStore
+}
+
+// MappingsStore persists the name mappings between virtual and host objects, so that
+// they survive syncer restarts and do not depend on annotations or the naming scheme.
+type MappingsStore interface {
+	// VirtualToHostName returns the recorded host name for the given virtual object.
+	VirtualToHostName(gvk schema.GroupVersionKind, vName types.NamespacedName) (types.NamespacedName, bool)
+
+	// HostToVirtualName returns the recorded virtual name for the given host object.
+	HostToVirtualName(gvk schema.GroupVersionKind, pName types.NamespacedName) (types.NamespacedName, bool)
+
+	// RecordMapping records a mapping between a virtual and a host object.
+	RecordMapping(gvk schema.GroupVersionKind, vName, pName types.NamespacedName)
+
+	// DeleteMapping removes the recorded mapping of the given virtual object.
+	DeleteMapping(gvk schema.GroupVersionKind, vName types.NamespacedName)
+
+	// List returns all recorded mappings.
+	List() []NameMapping
+}
+
+// NameMapping is a single recorded mapping between a virtual and a host object
+type NameMapping struct {
+	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind"`
+
+	Virtual types.NamespacedName `json:"virtual"`
+	Host    types.NamespacedName `json:"host"`
 }
 
 // Mapper holds the mapping logic for an object
@@ -2,30 +2,38 @@ package syncer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/loft-sh/vcluster/pkg/config"
-	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/patcher"
 	"github.com/loft-sh/vcluster/pkg/scheme"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"github.com/moby/locker"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
-	controller2 "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -41,6 +49,42 @@ func NewSyncController(ctx *synccontext.RegisterContext, syncer syncertypes.Sync
 		options = optionsProvider.Options()
 	}
 
+	vEventRecorder := ctx.VirtualManager.GetEventRecorderFor(syncer.Name() + "-syncer")
+
+	// detect host objects that were changed outside of vCluster
+	var drift synccontext.DriftDetector
+	if ctx.Config != nil {
+		conflictPolicy := ctx.Config.SyncController(syncer.Name()).ConflictPolicy
+		if conflictPolicy != "" {
+			drift = patcher.NewDriftDetector(conflictPolicy, vEventRecorder)
+		}
+	}
+
+	// only sync the virtual objects matching the configured selector
+	var selector syncertypes.ObjectExcluder
+	if ctx.Config != nil {
+		var err error
+		selector, err = newSelectorExcluder(ctx.Config.SyncController(syncer.Name()).Selector)
+		if err != nil {
+			return nil, fmt.Errorf("create selector for syncer %s: %w", syncer.Name(), err)
+		}
+	}
+
+	// in shadow mode we only record what we would change
+	syncerLog := loghelper.New(syncer.Name())
+	physicalClient := ctx.PhysicalManager.GetClient()
+	virtualClient := ctx.VirtualManager.GetClient()
+	currentNamespaceClient := ctx.CurrentNamespaceClient
+	shadow := ctx.Config != nil && ptr.Deref(ctx.Config.SyncController(syncer.Name()).Shadow, false)
+	if shadow {
+		syncerLog.Infof("running in shadow mode, changes will not be written")
+		physicalClient = newShadowClient(syncer.Name(), DirectionHost, physicalClient, syncerLog)
+		virtualClient = newShadowClient(syncer.Name(), DirectionVirtual, virtualClient, syncerLog)
+		if currentNamespaceClient != nil {
+			currentNamespaceClient = newShadowClient(syncer.Name(), DirectionHost, currentNamespaceClient, syncerLog)
+		}
+	}
+
 	return &SyncController{
 		syncer: syncer,
 
@@ -49,16 +93,19 @@ func NewSyncController(ctx *synccontext.RegisterContext, syncer syncertypes.Sync
 		config: ctx.Config,
 
 		mappings: ctx.Mappings,
+		drift:    drift,
 
-		log:            loghelper.New(syncer.Name()),
-		vEventRecorder: ctx.VirtualManager.GetEventRecorderFor(syncer.Name() + "-syncer"),
-		physicalClient: ctx.PhysicalManager.GetClient(),
+		log:            syncerLog,
+		vEventRecorder: vEventRecorder,
+		physicalClient: physicalClient,
 
 		currentNamespace:       ctx.CurrentNamespace,
-		currentNamespaceClient: ctx.CurrentNamespaceClient,
+		currentNamespaceClient: currentNamespaceClient,
 
-		virtualClient: ctx.VirtualManager.GetClient(),
+		virtualClient: virtualClient,
 		options:       options,
+		selector:      selector,
+		shadow:        shadow,
 
 		locker: locker.New(),
 	}, nil
@@ -81,6 +128,7 @@ type SyncController struct {
 	config *config.VirtualClusterConfig
 
 	mappings synccontext.MappingsRegistry
+	drift    synccontext.DriftDetector
 
 	log            loghelper.Logger
 	vEventRecorder record.EventRecorder
@@ -93,6 +141,16 @@ type SyncController struct {
 	virtualClient client.Client
 	options       *syncertypes.Options
 
+	// selector excludes virtual objects that should not get synced, can be nil
+	selector syncertypes.ObjectExcluder
+
+	// shadow signals that the syncer should not write anything
+	shadow bool
+
+	// paused holds the virtual objects whose sync is currently paused
+	paused     map[types.NamespacedName]bool
+	pausedLock sync.Mutex
+
 	locker *locker.Locker
 }
 
@@ -106,10 +164,18 @@ func (r *SyncController) newSyncContext(ctx context.Context, logName string) *sy
 		CurrentNamespaceClient: r.currentNamespaceClient,
 		VirtualClient:          r.virtualClient,
 		Mappings:               r.mappings,
+		Drift:                  r.drift,
 	}
 }
 
-func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_ ctrl.Result, err error) {
+func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (result ctrl.Result, err error) {
+	// record reconcile metrics, resolving the request and retrieving the objects count as get, errors
+	// returned by the syncer count as sync unless they are marked as translate or patch errors
+	start, operation := time.Now(), OperationGet
+	defer func() {
+		observeReconcile(r.syncer.Name(), operation, start, result, err)
+	}()
+
 	// extract if this was a delete request
 	origReq, syncEventType := fromDeleteRequest(origReq)
 
@@ -152,10 +218,22 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 	// retrieve the objects
 	vObj, pObj, err := r.getObjects(syncContext, vReq, pReq)
 	if err != nil {
+		if vObj != nil {
+			r.updateSyncError(syncContext, vObj, err)
+		}
 		return ctrl.Result{}, err
 	}
 
+	// skip objects whose sync was paused
+	paused, err := r.isPaused(syncContext, vReq.NamespacedName, vObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if paused {
+		return ctrl.Result{}, nil
+	}
+
 	// check what function we should call
+	operation = OperationSync
 	if vObj != nil && pObj != nil {
 		// make sure the object uid matches
 		pAnnotations := pObj.GetAnnotations()
@@ -169,20 +247,27 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 			return DeleteHostObject(syncContext, pObj, "virtual object uid is different")
 		}
 
-		return r.genericSyncer.Sync(syncContext, &synccontext.SyncEvent[client.Object]{
+		// remember the mapping between the objects
+		r.recordMapping(vObj, pObj)
+
+		result, err = r.genericSyncer.Sync(syncContext, &synccontext.SyncEvent[client.Object]{
 			Type:   syncEventType,
 			Source: syncEventSource,
 
 			Virtual: vObj,
 			Host:    pObj,
 		})
+		r.updateSyncError(syncContext, vObj, err)
+		return result, err
 	} else if vObj != nil {
-		return r.genericSyncer.SyncToHost(syncContext, &synccontext.SyncToHostEvent[client.Object]{
+		result, err = r.genericSyncer.SyncToHost(syncContext, &synccontext.SyncToHostEvent[client.Object]{
 			Type:   syncEventType,
 			Source: syncEventSource,
 
 			Virtual: vObj,
 		})
+		r.updateSyncError(syncContext, vObj, err)
+		return result, err
 	} else if pObj != nil {
 		if pObj.GetAnnotations() != nil {
 			if shouldSkip, ok := pObj.GetAnnotations()[translate.SkipBackSyncInMultiNamespaceMode]; ok && shouldSkip == "true" {
@@ -191,17 +276,135 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 			}
 		}
 
+		// the virtual object is gone, so the mapping is not needed anymore
+		r.deleteMapping(vReq.NamespacedName)
 		return r.genericSyncer.SyncToVirtual(syncContext, &synccontext.SyncToVirtualEvent[client.Object]{
 			Type:   syncEventType,
 			Source: syncEventSource,
 
 			Host: pObj,
 		})
+	} else if syncEventType == synccontext.SyncEventTypeDelete {
+		r.deleteMapping(vReq.NamespacedName)
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// isPaused checks if the sync of the virtual object or its namespace was paused and keeps track of the
+// paused objects for the metrics
+func (r *SyncController) isPaused(ctx *synccontext.SyncContext, vName types.NamespacedName, vObj client.Object) (bool, error) {
+	paused := vObj != nil && vObj.GetAnnotations()[translate.SyncPausedAnnotation] == "true"
+	if !paused && vName.Namespace != "" {
+		vNamespace := &corev1.Namespace{}
+		err := r.virtualClient.Get(ctx, types.NamespacedName{Name: vName.Namespace}, vNamespace)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return false, fmt.Errorf("get virtual namespace: %w", err)
+		}
+
+		paused = vNamespace.Annotations[translate.SyncPausedAnnotation] == "true"
+	}
+
+	// only existing objects are counted as paused
+	if !paused || vObj == nil {
+		r.forgetPaused(vName)
+		return paused, nil
+	}
+
+	r.pausedLock.Lock()
+	defer r.pausedLock.Unlock()
+	if r.paused == nil {
+		r.paused = map[types.NamespacedName]bool{}
+	}
+	r.paused[vName] = true
+	observePaused(r.syncer.Name(), len(r.paused))
+	return paused, nil
+}
+
+// forgetPaused removes the virtual object from the paused objects
+func (r *SyncController) forgetPaused(vName types.NamespacedName) {
+	r.pausedLock.Lock()
+	defer r.pausedLock.Unlock()
+	delete(r.paused, vName)
+	observePaused(r.syncer.Name(), len(r.paused))
+}
+
+// updateSyncError surfaces the given sync error on the virtual object or clears a previous one
+// if the sync succeeded. The warning event is only emitted if the error changed to avoid flooding
+// the virtual cluster with events on every retry.
+func (r *SyncController) updateSyncError(ctx *synccontext.SyncContext, vObj client.Object, syncErr error) {
+	if vObj.GetDeletionTimestamp() != nil {
+		return
+	} else if syncErr != nil && (kerrors.IsConflict(syncErr) || kerrors.IsNotFound(syncErr)) {
+		// these resolve themselves on the next reconcile
+		return
+	}
+
+	message := ""
+	if syncErr != nil {
+		message = syncErr.Error()
+	}
+	if vObj.GetAnnotations()[translate.SyncErrorAnnotation] == message {
+		return
+	}
+
+	annotations := map[string]interface{}{
+		translate.SyncErrorAnnotation:          nil,
+		translate.SyncErrorTimestampAnnotation: nil,
+	}
+	if syncErr != nil {
+		annotations[translate.SyncErrorAnnotation] = message
+		annotations[translate.SyncErrorTimestampAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		r.vEventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing: %v", syncErr)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		ctx.Log.Infof("error marshalling sync error patch: %v", err)
+		return
+	}
+
+	err = ctx.VirtualClient.Patch(ctx, vObj, client.RawPatch(types.MergePatchType, patch))
+	if err != nil && !kerrors.IsNotFound(err) {
+		ctx.Log.Infof("error updating sync error on virtual object %s/%s: %v", vObj.GetNamespace(), vObj.GetName(), err)
+	}
+}
+
+func (r *SyncController) recordMapping(vObj, pObj client.Object) {
+	if r.shadow {
+		return
+	}
+
+	recordMapping(r.mappings, r.syncer.GroupVersionKind(), vObj, pObj)
+}
+
+func recordMapping(mappings synccontext.MappingsRegistry, gvk schema.GroupVersionKind, vObj, pObj client.Object) {
+	if mappings == nil || mappings.Store() == nil {
+		return
+	}
+
+	// mirrored objects don't need a mapping as the name is the same
+	vName := types.NamespacedName{Namespace: vObj.GetNamespace(), Name: vObj.GetName()}
+	pName := types.NamespacedName{Namespace: pObj.GetNamespace(), Name: pObj.GetName()}
+	if vName == pName {
+		return
+	}
+
+	mappings.Store().RecordMapping(gvk, vName, pName)
+}
+
+func (r *SyncController) deleteMapping(vName types.NamespacedName) {
+	if r.shadow || r.mappings == nil || r.mappings.Store() == nil {
+		return
+	}
+
+	r.mappings.Store().DeleteMapping(r.syncer.GroupVersionKind(), vName)
+}
+
 func (r *SyncController) getObjects(ctx *synccontext.SyncContext, vReq, pReq ctrl.Request) (vObj client.Object, pObj client.Object, err error) {
 	// if we got a host request, we retrieve host object first
 	if pReq.Name != "" {
@@ -241,10 +444,10 @@ func (r *SyncController) getObjectsFromVirtual(ctx *synccontext.SyncContext, req
 		return nil, nil, nil
 	}
 
-	// get physical object
+	// get physical object, the virtual object is returned with the error so it can be surfaced on it
 	exclude, pObj, err = r.getPhysicalObject(ctx, r.syncer.VirtualToHost(ctx, req.NamespacedName, vObj), vObj)
 	if err != nil {
-		return nil, nil, err
+		return vObj, nil, err
 	} else if exclude {
 		return nil, nil, nil
 	}
@@ -316,9 +519,7 @@ func (r *SyncController) excludePhysical(ctx *synccontext.SyncContext, pObj, vOb
 		return false, fmt.Errorf("failed to check if physical object is managed: %w", err)
 	} else if !isManaged {
 		if !excluderOk && vObj != nil {
-			msg := fmt.Sprintf("conflict: cannot sync virtual object %s/%s as unmanaged physical object %s/%s exists with desired name", vObj.GetNamespace(), vObj.GetName(), pObj.GetNamespace(), pObj.GetName())
-			r.vEventRecorder.Eventf(vObj, "Warning", "SyncError", msg)
-			return false, fmt.Errorf(msg)
+			return false, fmt.Errorf("conflict: cannot sync virtual object %s/%s as unmanaged physical object %s/%s exists with desired name", vObj.GetNamespace(), vObj.GetName(), pObj.GetNamespace(), pObj.GetName())
 		}
 
 		return true, nil
@@ -339,6 +540,10 @@ func (r *SyncController) excludePhysical(ctx *synccontext.SyncContext, pObj, vOb
 }
 
 func (r *SyncController) excludeVirtual(vObj client.Object) bool {
+	if r.selector != nil && r.selector.ExcludeVirtual(vObj) {
+		return true
+	}
+
 	excluder, ok := r.syncer.(syncertypes.ObjectExcluder)
 	if ok {
 		return excluder.ExcludeVirtual(vObj)
@@ -379,9 +584,13 @@ func (r *SyncController) enqueueVirtual(ctx context.Context, obj client.Object,
 	if obj == nil {
 		return
 	}
+	observeEvent(r.syncer.Name(), DirectionVirtual)
 
 	// add a new request for the host object as otherwise this information might be lost after a delete event
 	if isDelete {
+		// deleted objects aren't paused anymore
+		r.forgetPaused(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+
 		// add a new request for the host object
 		name := r.syncer.VirtualToHost(r.newSyncContext(ctx, obj.GetName()), types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, obj)
 		if name.Name != "" {
@@ -425,6 +634,7 @@ func (r *SyncController) enqueuePhysical(ctx context.Context, obj client.Object,
 	} else if !managed {
 		return
 	}
+	observeEvent(r.syncer.Name(), DirectionHost)
 
 	// add a new request for the virtual object as otherwise this information might be lost after a delete event
 	if isDelete {
@@ -455,17 +665,56 @@ func (r *SyncController) enqueuePhysical(ctx context.Context, obj client.Object,
 	}))
 }
 
+// enqueueNamespace enqueues all virtual objects of a namespace whose sync was paused or resumed
+func (r *SyncController) enqueueNamespace(ctx context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	if evt.ObjectOld == nil || evt.ObjectNew == nil || evt.ObjectOld.GetAnnotations()[translate.SyncPausedAnnotation] == evt.ObjectNew.GetAnnotations()[translate.SyncPausedAnnotation] {
+		return
+	}
+
+	gvk, err := apiutil.GVKForObject(r.syncer.Resource(), scheme.Scheme)
+	if err != nil {
+		klog.Errorf("error retrieving gvk of %s: %v", r.syncer.Name(), err)
+		return
+	}
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	list, err := scheme.Scheme.New(listGVK)
+	if err != nil {
+		list = &unstructured.UnstructuredList{}
+		list.GetObjectKind().SetGroupVersionKind(listGVK)
+	}
+
+	err = r.virtualClient.List(ctx, list.(client.ObjectList), client.InNamespace(evt.ObjectNew.GetName()))
+	if err != nil {
+		klog.Errorf("error listing %s in namespace %s: %v", r.syncer.Name(), evt.ObjectNew.GetName(), err)
+		return
+	}
+
+	err = meta.EachListItem(list, func(obj runtime.Object) error {
+		r.enqueueVirtual(ctx, obj.(client.Object), q, false)
+		return nil
+	})
+	if err != nil {
+		klog.Errorf("error enqueuing %s in namespace %s: %v", r.syncer.Name(), evt.ObjectNew.GetName(), err)
+	}
+}
+
 func (r *SyncController) Register(ctx *synccontext.RegisterContext) error {
 	// build the basic controller
 	controller := ctrl.NewControllerManagedBy(ctx.VirtualManager).
-		WithOptions(controller2.Options{
-			MaxConcurrentReconciles: 10,
-			CacheSyncTimeout:        constants.DefaultCacheSyncTimeout,
-		}).
+		WithOptions(ctx.ControllerOptions(r.syncer.Name())).
 		Named(r.syncer.Name()).
 		Watches(r.syncer.Resource(), newEventHandler(r.enqueueVirtual)).
 		WatchesRawSource(source.Kind(ctx.PhysicalManager.GetCache(), r.syncer.Resource(), newEventHandler(r.enqueuePhysical)))
 
+	// objects within a namespace need to be synced again once the sync of the namespace is resumed
+	namespaced, err := apiutil.IsObjectNamespaced(r.syncer.Resource(), scheme.Scheme, ctx.VirtualManager.GetRESTMapper())
+	if err != nil {
+		return fmt.Errorf("check if %s is namespaced: %w", r.syncer.Name(), err)
+	} else if namespaced {
+		controller = controller.Watches(&corev1.Namespace{}, handler.Funcs{UpdateFunc: r.enqueueNamespace})
+	}
+
 	// should add extra stuff?
 	modifier, isControllerModifier := r.syncer.(syncertypes.ControllerModifier)
 	if isControllerModifier {
@@ -479,7 +728,7 @@ func (r *SyncController) Register(ctx *synccontext.RegisterContext) error {
 	return controller.Complete(r)
 }
 
-func CreateHostObject(ctx *synccontext.SyncContext, vObj, pObj client.Object, eventRecorder record.EventRecorder) (ctrl.Result, error) {
+func CreateHostObject(ctx *synccontext.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
 	gvk, err := apiutil.GVKForObject(pObj, scheme.Scheme)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("gvk for object: %w", err)
@@ -493,10 +742,15 @@ func CreateHostObject(ctx *synccontext.SyncContext, vObj, pObj client.Object, ev
 			return ctrl.Result{RequeueAfter: time.Second}, nil
 		}
 		ctx.Log.Infof("error syncing %s %s/%s to host cluster: %v", gvk.Kind, vObj.GetNamespace(), vObj.GetName(), err)
-		eventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing to host cluster: %v", err)
 		return ctrl.Result{}, err
 	}
 
+	// record the mapping right away, so the host object can be mapped back before the next reconcile,
+	// in shadow mode nothing was created, so there is nothing to record
+	if _, shadow := ctx.PhysicalClient.(*shadowClient); !shadow {
+		recordMapping(ctx.Mappings, gvk, vObj, pObj)
+	}
+
 	return ctrl.Result{}, nil
 }
 
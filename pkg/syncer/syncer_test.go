@@ -6,6 +6,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/scheme"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
@@ -18,12 +19,16 @@ import (
 	"github.com/moby/locker"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 )
 
 // named mock instead of fake because there's a real "fake" syncer that syncs fake objects
@@ -58,7 +63,7 @@ func (s *mockSyncer) SyncToHost(ctx *synccontext.SyncContext, event *synccontext
 		return ctrl.Result{}, errors.New("naive translate create failed")
 	}
 
-	return CreateHostObject(ctx, event.Virtual, pObj, s.EventRecorder())
+	return CreateHostObject(ctx, event.Virtual, pObj)
 }
 
 // Sync is called to sync a virtual object with a physical object
@@ -217,17 +222,26 @@ func TestReconcile(t *testing.T) {
 			},
 
 			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
-				// existing secret should remain
+				// existing secret should remain and show the conflict
 				corev1.SchemeGroupVersion.WithKind("Secret"): {
 					&corev1.Secret{
 						ObjectMeta: metav1.ObjectMeta{
 							Name:      "a",
 							Namespace: namespaceInVclusterA,
 							UID:       "123",
+							Annotations: map[string]string{
+								translate.SyncErrorAnnotation: "conflict: cannot sync virtual object default/a as unmanaged physical object test/a-x-default-x-suffix exists with desired name",
+							},
 						},
 					},
 				},
 			},
+			Compare: func(obj1 runtime.Object, obj2 runtime.Object) bool {
+				// the sync error timestamp changes on every run
+				obj2 = obj2.DeepCopyObject()
+				delete(obj2.(*corev1.Secret).Annotations, translate.SyncErrorTimestampAnnotation)
+				return apiequality.Semantic.DeepEqual(obj1, obj2)
+			},
 
 			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
 				// existing secret should remain
@@ -334,3 +348,253 @@ func TestReconcile(t *testing.T) {
 		}
 	}
 }
+
+type failingSyncer struct {
+	*mockSyncer
+
+	err error
+}
+
+func (s *failingSyncer) Syncer() syncertypes.Sync[client.Object] {
+	return ToGenericSyncer[*corev1.Secret](s)
+}
+
+func (s *failingSyncer) SyncToHost(_ *synccontext.SyncContext, _ *synccontext.SyncToHostEvent[*corev1.Secret]) (ctrl.Result, error) {
+	return ctrl.Result{}, s.err
+}
+
+func TestSyncErrorAnnotation(t *testing.T) {
+	ctx := context.Background()
+	vSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a",
+			Namespace: namespaceInVclusterA,
+			UID:       "123",
+		},
+	}
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, vSecret)
+	fakeContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+	syncerImpl, err := NewMockSyncer(fakeContext)
+	assert.NilError(t, err)
+	syncer := &failingSyncer{mockSyncer: syncerImpl.(*mockSyncer), err: errors.New("denied by admission webhook")}
+
+	eventRecorder := record.NewFakeRecorder(10)
+	controller := &SyncController{
+		syncer: syncer,
+
+		genericSyncer: syncer.Syncer(),
+
+		log:            loghelper.New(syncer.Name()),
+		vEventRecorder: eventRecorder,
+		physicalClient: pClient,
+
+		currentNamespace:       fakeContext.CurrentNamespace,
+		currentNamespaceClient: fakeContext.CurrentNamespaceClient,
+
+		mappings: fakeContext.Mappings,
+
+		virtualClient: vClient,
+		options:       &syncertypes.Options{},
+
+		locker: locker.New(),
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "a", Namespace: namespaceInVclusterA}}
+
+	// the error should be written to the virtual object and only be reported once
+	for i := 0; i < 2; i++ {
+		_, err = controller.Reconcile(ctx, req)
+		assert.ErrorContains(t, err, "denied by admission webhook")
+	}
+	assert.NilError(t, vClient.Get(ctx, req.NamespacedName, vSecret))
+	assert.Equal(t, vSecret.Annotations[translate.SyncErrorAnnotation], "denied by admission webhook")
+	assert.Assert(t, vSecret.Annotations[translate.SyncErrorTimestampAnnotation] != "")
+	assert.Equal(t, len(eventRecorder.Events), 1)
+
+	// a successful sync should clear the error again
+	syncer.err = nil
+	_, err = controller.Reconcile(ctx, req)
+	assert.NilError(t, err)
+	assert.NilError(t, vClient.Get(ctx, req.NamespacedName, vSecret))
+	_, ok := vSecret.Annotations[translate.SyncErrorAnnotation]
+	assert.Assert(t, !ok)
+	_, ok = vSecret.Annotations[translate.SyncErrorTimestampAnnotation]
+	assert.Assert(t, !ok)
+}
+
+func TestCreateHostObjectRecordsMapping(t *testing.T) {
+	vConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a",
+			Namespace: namespaceInVclusterA,
+		},
+	}
+	// use a host name the current naming wouldn't produce, e.g. after a naming change
+	pConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "renamed-a",
+			Namespace: syncertesting.DefaultTestTargetNamespace,
+		},
+	}
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, vConfigMap)
+	fakeContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+	syncContext := fakeContext.ToSyncContext("test")
+	syncContext.Log = loghelper.New("test")
+
+	_, err := CreateHostObject(syncContext, vConfigMap, pConfigMap)
+	assert.NilError(t, err)
+
+	// the mapper should follow the recorded mapping in both directions
+	vName := types.NamespacedName{Name: vConfigMap.Name, Namespace: vConfigMap.Namespace}
+	pName := types.NamespacedName{Name: pConfigMap.Name, Namespace: pConfigMap.Namespace}
+	mapper, err := fakeContext.Mappings.ByGVK(mappings.ConfigMaps())
+	assert.NilError(t, err)
+	assert.Equal(t, mapper.VirtualToHost(syncContext, vName, vConfigMap), pName)
+	assert.Equal(t, mapper.HostToVirtual(syncContext, pName, pConfigMap), vName)
+}
+
+func TestSelector(t *testing.T) {
+	ctx := context.Background()
+	newSecret := func(name, namespace string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       types.UID(name),
+				Labels:    labels,
+			},
+		}
+	}
+	exposed := newSecret("exposed", namespaceInVclusterA, map[string]string{"expose": "true"})
+	unlabeled := newSecret("unlabeled", namespaceInVclusterA, nil)
+	sandboxed := newSecret("sandboxed", "sandbox-1", map[string]string{"expose": "true"})
+
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, exposed, unlabeled, sandboxed)
+	fakeContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+	syncer, err := NewMockSyncer(fakeContext)
+	assert.NilError(t, err)
+	selector, err := newSelectorExcluder(config.SyncControllerSelector{
+		Labels:             map[string]string{"expose": "true"},
+		ExcludedNamespaces: []string{"sandbox-*"},
+	})
+	assert.NilError(t, err)
+
+	controller := &SyncController{
+		syncer: syncer.(syncertypes.Syncer),
+
+		genericSyncer: syncer.(syncertypes.Syncer).Syncer(),
+
+		log:            loghelper.New(syncer.Name()),
+		vEventRecorder: record.NewFakeRecorder(10),
+		physicalClient: pClient,
+
+		currentNamespace:       fakeContext.CurrentNamespace,
+		currentNamespaceClient: fakeContext.CurrentNamespaceClient,
+
+		mappings: fakeContext.Mappings,
+
+		virtualClient: vClient,
+		options:       &syncertypes.Options{},
+		selector:      selector,
+
+		locker: locker.New(),
+	}
+
+	// only the exposed secret outside of the sandbox should get synced
+	for _, vSecret := range []*corev1.Secret{exposed, unlabeled, sandboxed} {
+		_, err = controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: vSecret.Name, Namespace: vSecret.Namespace}})
+		assert.NilError(t, err)
+	}
+	pSecrets := &corev1.SecretList{}
+	assert.NilError(t, pClient.List(ctx, pSecrets))
+	assert.Equal(t, len(pSecrets.Items), 1)
+	assert.Equal(t, pSecrets.Items[0].Name, translate.Default.HostName(exposed.Name, exposed.Namespace))
+}
+
+func TestSyncPaused(t *testing.T) {
+	ctx := context.Background()
+	pausedNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "paused",
+			Annotations: map[string]string{translate.SyncPausedAnnotation: "true"},
+		},
+	}
+	pausedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "paused",
+			Namespace:   namespaceInVclusterA,
+			UID:         "123",
+			Annotations: map[string]string{translate.SyncPausedAnnotation: "true"},
+		},
+	}
+	namespacedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: pausedNamespace.Name,
+			UID:       "456",
+		},
+	}
+
+	pClient := testingutil.NewFakeClient(scheme.Scheme)
+	vClient := testingutil.NewFakeClient(scheme.Scheme, pausedNamespace, pausedSecret, namespacedSecret)
+	fakeContext := syncertesting.NewFakeRegisterContext(syncertesting.NewFakeConfig(), pClient, vClient)
+	syncer, err := NewMockSyncer(fakeContext)
+	assert.NilError(t, err)
+
+	controller := &SyncController{
+		syncer: syncer.(syncertypes.Syncer),
+
+		genericSyncer: syncer.(syncertypes.Syncer).Syncer(),
+
+		log:            loghelper.New(syncer.Name()),
+		vEventRecorder: record.NewFakeRecorder(10),
+		physicalClient: pClient,
+
+		currentNamespace:       fakeContext.CurrentNamespace,
+		currentNamespaceClient: fakeContext.CurrentNamespaceClient,
+
+		mappings: fakeContext.Mappings,
+
+		virtualClient: vClient,
+		options:       &syncertypes.Options{},
+
+		locker: locker.New(),
+	}
+
+	// neither the paused secret nor the secret in the paused namespace should get synced
+	for _, vSecret := range []*corev1.Secret{pausedSecret, namespacedSecret} {
+		_, err = controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: vSecret.Name, Namespace: vSecret.Namespace}})
+		assert.NilError(t, err)
+	}
+	pSecrets := &corev1.SecretList{}
+	assert.NilError(t, pClient.List(ctx, pSecrets))
+	assert.Equal(t, len(pSecrets.Items), 0)
+	assert.Equal(t, len(controller.paused), 2)
+
+	// resuming the sync should sync the secret again
+	delete(pausedSecret.Annotations, translate.SyncPausedAnnotation)
+	assert.NilError(t, vClient.Update(ctx, pausedSecret))
+	_, err = controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: pausedSecret.Name, Namespace: pausedSecret.Namespace}})
+	assert.NilError(t, err)
+	assert.NilError(t, pClient.List(ctx, pSecrets))
+	assert.Equal(t, len(pSecrets.Items), 1)
+	assert.Equal(t, len(controller.paused), 1)
+
+	// resuming the namespace should enqueue its objects again
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	resumedNamespace := pausedNamespace.DeepCopy()
+	resumedNamespace.Annotations = nil
+	controller.enqueueNamespace(ctx, event.UpdateEvent{ObjectOld: pausedNamespace, ObjectNew: pausedNamespace}, queue)
+	assert.Equal(t, queue.Len(), 0)
+	controller.enqueueNamespace(ctx, event.UpdateEvent{ObjectOld: pausedNamespace, ObjectNew: resumedNamespace}, queue)
+	assert.Equal(t, queue.Len(), 1)
+	req, _ := queue.Get()
+	assert.Equal(t, req.(ctrl.Request).NamespacedName, types.NamespacedName{Name: namespacedSecret.Name, Namespace: namespacedSecret.Namespace})
+
+	// deleting a paused object should remove it from the paused objects
+	controller.enqueueVirtual(ctx, namespacedSecret, queue, true)
+	assert.Equal(t, len(controller.paused), 0)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/mappings/resources"
+	"github.com/loft-sh/vcluster/pkg/mappings/store"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncer "github.com/loft-sh/vcluster/pkg/syncer/types"
 	"github.com/loft-sh/vcluster/pkg/util"
@@ -62,13 +63,16 @@ func NewFakeRegisterContext(vConfig *config.VirtualClusterConfig, pClient *testi
 		CurrentNamespaceClient: pClient,
 		VirtualManager:         newFakeManager(vClient),
 		PhysicalManager:        newFakeManager(pClient),
-		Mappings:               mappings.NewMappingsRegistry(),
+		Mappings:               mappings.NewMappingsRegistry(store.NewMemoryStore()),
 	}
 
 	// make sure we do not ensure any CRDs
 	util.EnsureCRD = func(_ context.Context, _ *rest.Config, _ []byte, _ schema.GroupVersionKind) error {
 		return nil
 	}
+	translate.EnsureCRDFromPhysicalCluster = func(_ context.Context, _ *rest.Config, _ *rest.Config, _ schema.GroupVersionKind) (bool, bool, error) {
+		return false, true, nil
+	}
 
 	resources.MustRegisterMappings(registerCtx)
 	return registerCtx
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NewUnstructured creates an unstructured object with the given top level fields, e.g. spec or status
+func NewUnstructured(gvk schema.GroupVersionKind, name, namespace string, annotations, labels map[string]string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for key, value := range fields {
+		obj.Object[key] = value
+	}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetAnnotations(annotations)
+	obj.SetLabels(labels)
+	return obj
+}
+
+// HostAnnotations returns the annotations a syncer sets on the host object of the given virtual object
+func HostAnnotations(name, namespace string, gvk schema.GroupVersionKind) map[string]string {
+	return map[string]string{
+		translate.NameAnnotation:      name,
+		translate.NamespaceAnnotation: namespace,
+		translate.UIDAnnotation:       "",
+		translate.KindAnnotation:      gvk.String(),
+	}
+}
+
+// HostLabels returns the labels a syncer sets on the host object of a virtual object without labels
+func HostLabels(namespace string) map[string]string {
+	return map[string]string{
+		translate.MarkerLabel:    translate.VClusterName,
+		translate.NamespaceLabel: namespace,
+	}
+}
+
+// AssertSecretNames checks that secretNames returns the expected namespace/name of the secrets referenced by obj
+// in any order
+func AssertSecretNames(t *testing.T, secretNames func(obj *unstructured.Unstructured) []string, obj *unstructured.Unstructured, expected ...string) {
+	names := append([]string{}, secretNames(obj)...)
+	slices.Sort(names)
+	expected = append([]string{}, expected...)
+	slices.Sort(expected)
+	assert.DeepEqual(t, names, expected)
+}
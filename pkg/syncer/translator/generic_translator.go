@@ -1,8 +1,11 @@
 package translator
 
 import (
+	"errors"
+
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	syncertypes "github.com/loft-sh/vcluster/pkg/syncer/types"
+	"github.com/loft-sh/vcluster/pkg/util"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -40,3 +43,28 @@ func (n *genericTranslator) Name() string {
 func (n *genericTranslator) Resource() client.Object {
 	return n.obj.DeepCopyObject().(client.Object)
 }
+
+// NewTranslateError marks an error that happened while translating an object
+func NewTranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return translateError{err}
+}
+
+type translateError struct {
+	error
+}
+
+func (t translateError) Unwrap() error {
+	return t.error
+}
+
+// IsTranslateError returns true if the error or one of the errors it wraps or aggregates happened while translating
+// an object
+func IsTranslateError(err error) bool {
+	return util.ContainsError(err, func(err error) bool {
+		return errors.As(err, &translateError{})
+	})
+}
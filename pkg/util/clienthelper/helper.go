@@ -63,10 +63,14 @@ func GetByIndex(ctx context.Context, c client.Client, obj runtime.Object, index,
 			return err
 		}
 
-		unstructuredList := &unstructured.UnstructuredList{}
+		list = &unstructured.UnstructuredList{}
+	}
+
+	// unstructured lists need the kind to be set, even if they were registered in the scheme
+	unstructuredList, ok := list.(*unstructured.UnstructuredList)
+	if ok {
 		unstructuredList.SetKind(gvk.Kind + "List")
 		unstructuredList.SetAPIVersion(gvk.GroupVersion().String())
-		list = unstructuredList
 	}
 
 	err = c.List(ctx, list.(client.ObjectList), client.MatchingFields{index: value})
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 func GetCause(err error) string {
@@ -24,3 +25,24 @@ func GetCause(err error) string {
 
 	return err.Error()
 }
+
+// ContainsError returns true if matches is true for the error or one of the errors it aggregates. Aggregates only
+// implement Is, so errors.As doesn't find errors within them.
+func ContainsError(err error, matches func(err error) bool) bool {
+	if err == nil {
+		return false
+	} else if matches(err) {
+		return true
+	}
+
+	var aggregate utilerrors.Aggregate
+	if errors.As(err, &aggregate) {
+		for _, aggregatedErr := range aggregate.Errors() {
+			if ContainsError(aggregatedErr, matches) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package hostnaming
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/loft-sh/vcluster/pkg/util/base36"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// MaxNamespacedNameLength is the maximum length of a host name for namespaced objects
+	MaxNamespacedNameLength = 63
+
+	// MaxClusterScopedNameLength is the maximum length of a host name for cluster scoped objects
+	MaxClusterScopedNameLength = 253
+
+	// hashLength is the length of the hash suffix that makes templated host names unique
+	hashLength = 10
+)
+
+// Values are the values that can be used within a host naming template
+type Values struct {
+	Name            string
+	Namespace       string
+	VClusterName    string
+	TargetNamespace string
+}
+
+// Template renders host names from a host naming template
+type Template struct {
+	template   *template.Template
+	namespaced bool
+}
+
+// Parse parses the given host naming template. Host names of namespaced objects are rendered as
+// DNS labels, host names of cluster scoped objects as DNS subdomains.
+func Parse(name, text string, namespaced bool) (*Template, error) {
+	parsed, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{
+		template:   parsed,
+		namespaced: namespaced,
+	}, nil
+}
+
+// Render executes the template without making the result unique
+func (t *Template) Render(values Values) (string, error) {
+	out := &strings.Builder{}
+	err := t.template.Execute(out, values)
+	if err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// HostName renders the host name for the given values. A hash over the virtual name, namespace and
+// vCluster name is appended, so two objects can never end up with the same host name, even if the
+// template renders the same name for them.
+func (t *Template) HostName(values Values) (string, error) {
+	rendered, err := t.Render(values)
+	if err != nil {
+		return "", err
+	} else if rendered == "" {
+		return "", fmt.Errorf("template renders an empty name")
+	}
+
+	maxLength, validateName := MaxClusterScopedNameLength, validation.IsDNS1123Subdomain
+	if t.namespaced {
+		maxLength, validateName = MaxNamespacedNameLength, validation.IsDNS1123Label
+	}
+
+	digest := sha256.Sum256([]byte(strings.Join([]string{values.Name, "x", values.Namespace, "x", values.VClusterName}, "-")))
+	if len(rendered) > maxLength-hashLength-1 {
+		rendered = rendered[:maxLength-hashLength-1]
+	}
+
+	hostName := strings.TrimRight(rendered, ".-") + "-" + base36.EncodeBytes(digest[:])[0:hashLength]
+	if errs := validateName(hostName); len(errs) > 0 {
+		return "", fmt.Errorf("rendered name %q is invalid: %s", hostName, strings.Join(errs, ", "))
+	}
+
+	return hostName, nil
+}
+
+// Validate makes sure the template can be rendered into valid names and that it distinguishes
+// between different objects
+func Validate(name, text string, namespaced bool) error {
+	tpl, err := Parse(name, text, namespaced)
+	if err != nil {
+		return err
+	}
+
+	values := Values{
+		Name:            "name",
+		Namespace:       "namespace",
+		VClusterName:    "vcluster",
+		TargetNamespace: "target",
+	}
+	original, err := tpl.Render(values)
+	if err != nil {
+		return err
+	}
+
+	_, err = tpl.HostName(values)
+	if err != nil {
+		return err
+	}
+
+	otherName, err := tpl.Render(Values{Name: "other", Namespace: values.Namespace, VClusterName: values.VClusterName, TargetNamespace: values.TargetNamespace})
+	if err != nil {
+		return err
+	} else if otherName == original {
+		return fmt.Errorf("template needs to include {{.Name}}")
+	}
+
+	if namespaced {
+		otherNamespace, err := tpl.Render(Values{Name: values.Name, Namespace: "other", VClusterName: values.VClusterName, TargetNamespace: values.TargetNamespace})
+		if err != nil {
+			return err
+		} else if otherNamespace == original {
+			return fmt.Errorf("template needs to include {{.Namespace}}")
+		}
+	}
+
+	return nil
+}
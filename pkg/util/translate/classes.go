@@ -0,0 +1,17 @@
+package translate
+
+import (
+	"github.com/loft-sh/vcluster/config"
+)
+
+// HostClassName returns the host class name for the given virtual storage or ingress class name based on the given
+// class mappings. An empty class name resolves to the configured default host class. The second return value is false
+// if no mapping applies to the class.
+func HostClassName(classMappings config.ClassMappings, vName string) (string, bool) {
+	if vName == "" {
+		return classMappings.Default, classMappings.Default != ""
+	}
+
+	pName, ok := classMappings.Mappings[vName]
+	return pName, ok
+}
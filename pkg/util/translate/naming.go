@@ -0,0 +1,26 @@
+package translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/loft-sh/vcluster/pkg/util/hostnaming"
+)
+
+const (
+	// MaxNamespacedNameLength is the maximum length of a host name for namespaced objects
+	MaxNamespacedNameLength = hostnaming.MaxNamespacedNameLength
+
+	// MaxClusterScopedNameLength is the maximum length of a host name for cluster scoped objects
+	MaxClusterScopedNameLength = hostnaming.MaxClusterScopedNameLength
+)
+
+// SafeName shortens the given name with a hash if it exceeds the max length
+func SafeName(name string, maxLength int) string {
+	if len(name) > maxLength {
+		digest := sha256.Sum256([]byte(name))
+		return strings.ReplaceAll(name[0:maxLength-11]+"-"+hex.EncodeToString(digest[0:])[0:10], ".-", "-")
+	}
+	return name
+}
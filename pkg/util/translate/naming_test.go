@@ -0,0 +1,60 @@
+package translate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/loft-sh/vcluster/config"
+	"gotest.tools/assert"
+)
+
+func TestHostNaming(t *testing.T) {
+	translator, err := NewSingleNamespaceTranslatorWithNaming("target", config.SyncHostNaming{
+		Namespaced:    "{{.Namespace}}--{{.Name}}",
+		ClusterScoped: "{{.VClusterName}}--{{.Name}}",
+	})
+	assert.NilError(t, err)
+
+	hostName := translator.HostName("test", "default")
+	assert.Assert(t, strings.HasPrefix(hostName, "default--test-"))
+	assert.Assert(t, strings.HasPrefix(translator.HostNameCluster("test"), VClusterName+"--test-"))
+
+	// the same object should always get the same name
+	assert.Equal(t, translator.HostName("test", "default"), hostName)
+
+	// different objects rendering to the same name should get different names regardless of the order
+	otherTranslator, err := NewSingleNamespaceTranslatorWithNaming("target", config.SyncHostNaming{Namespaced: "{{.Namespace}}--{{.Name}}"})
+	assert.NilError(t, err)
+	assert.Assert(t, translator.HostName("b", "a-") != translator.HostName("-b", "a"))
+	assert.Equal(t, otherTranslator.HostName("-b", "a"), translator.HostName("-b", "a"))
+	assert.Equal(t, otherTranslator.HostName("b", "a-"), translator.HostName("b", "a-"))
+
+	// short names should differ for objects rendering to the same name and for different vClusters
+	assert.Assert(t, translator.HostNameShort("b", "a-") != translator.HostNameShort("-b", "a"))
+	nameTranslator, err := NewSingleNamespaceTranslatorWithNaming("target", config.SyncHostNaming{Namespaced: "{{.Name}}"})
+	assert.NilError(t, err)
+	shortName := nameTranslator.HostNameShort("test", "default")
+	assert.Assert(t, nameTranslator.HostNameShort("test", "other") != shortName)
+	vClusterName := VClusterName
+	defer func() {
+		VClusterName = vClusterName
+	}()
+	VClusterName = "other-vcluster"
+	assert.Assert(t, nameTranslator.HostNameShort("test", "default") != shortName)
+	VClusterName = vClusterName
+
+	// too long names should be shortened
+	longName := translator.HostName(strings.Repeat("a", 100), "default")
+	assert.Equal(t, len(longName), MaxNamespacedNameLength)
+	longClusterName := translator.HostNameCluster(strings.Repeat("a", 100))
+	assert.Assert(t, strings.HasPrefix(longClusterName, VClusterName+"--"+strings.Repeat("a", 100)+"-"))
+
+	// names that are not valid within the host cluster fall back to the default naming
+	upperTranslator, err := NewSingleNamespaceTranslatorWithNaming("target", config.SyncHostNaming{Namespaced: "{{.Namespace}}_{{.Name}}"})
+	assert.NilError(t, err)
+	assert.Equal(t, upperTranslator.HostName("test", "default"), SingleNamespaceHostName("test", "default", VClusterName))
+
+	// invalid templates should be rejected
+	_, err = NewSingleNamespaceTranslatorWithNaming("target", config.SyncHostNaming{Namespaced: "{{.Name"})
+	assert.ErrorContains(t, err, "parse namespaced host naming template")
+}
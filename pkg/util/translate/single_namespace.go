@@ -3,12 +3,15 @@ package translate
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"strings"
 
+	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/mappings"
 	"github.com/loft-sh/vcluster/pkg/scheme"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"github.com/loft-sh/vcluster/pkg/util/base36"
+	"github.com/loft-sh/vcluster/pkg/util/hostnaming"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,8 +26,35 @@ func NewSingleNamespaceTranslator(targetNamespace string) Translator {
 	}
 }
 
+// NewSingleNamespaceTranslatorWithNaming creates a new single namespace translator that uses
+// the given host naming templates
+func NewSingleNamespaceTranslatorWithNaming(targetNamespace string, naming config.SyncHostNaming) (Translator, error) {
+	translator := &singleNamespace{
+		targetNamespace: targetNamespace,
+	}
+
+	var err error
+	if naming.Namespaced != "" {
+		translator.namespacedNaming, err = hostnaming.Parse("namespaced", naming.Namespaced, true)
+		if err != nil {
+			return nil, fmt.Errorf("parse namespaced host naming template: %w", err)
+		}
+	}
+	if naming.ClusterScoped != "" {
+		translator.clusterScopedNaming, err = hostnaming.Parse("clusterScoped", naming.ClusterScoped, false)
+		if err != nil {
+			return nil, fmt.Errorf("parse cluster scoped host naming template: %w", err)
+		}
+	}
+
+	return translator, nil
+}
+
 type singleNamespace struct {
 	targetNamespace string
+
+	namespacedNaming    *hostnaming.Template
+	clusterScopedNaming *hostnaming.Template
 }
 
 func (s *singleNamespace) SingleNamespaceTarget() bool {
@@ -32,6 +62,15 @@ func (s *singleNamespace) SingleNamespaceTarget() bool {
 }
 
 func (s *singleNamespace) HostName(name, namespace string) string {
+	if name != "" && s.namespacedNaming != nil {
+		hostName, err := s.namespacedNaming.HostName(s.templateValues(name, namespace))
+		if err == nil {
+			return hostName
+		}
+
+		klog.Background().Error(err, "execute host naming template, falling back to default naming", "name", name, "namespace", namespace)
+	}
+
 	return SingleNamespaceHostName(name, namespace, VClusterName)
 }
 
@@ -40,11 +79,32 @@ func (s *singleNamespace) HostNameShort(name, namespace string) string {
 		return ""
 	}
 
+	// always hash the virtual object and the vCluster, as templates could render the same name for different objects,
+	// and add the templated name if there is one, so the short name follows the same naming
+	toHash := strings.Join([]string{name, "x", namespace, "x", VClusterName}, "-")
+	if s.namespacedNaming != nil {
+		rendered, err := s.namespacedNaming.Render(s.templateValues(name, namespace))
+		if err == nil {
+			toHash = strings.Join([]string{toHash, "x", rendered}, "-")
+		} else {
+			klog.Background().Error(err, "execute host naming template, falling back to default short naming", "name", name, "namespace", namespace)
+		}
+	}
+
 	// we use base36 to avoid as much conflicts as possible
-	digest := sha256.Sum256([]byte(strings.Join([]string{name, "x", namespace, "x", VClusterName}, "-")))
+	digest := sha256.Sum256([]byte(toHash))
 	return base36.EncodeBytes(digest[:])[0:10]
 }
 
+func (s *singleNamespace) templateValues(name, namespace string) hostnaming.Values {
+	return hostnaming.Values{
+		Name:            name,
+		Namespace:       namespace,
+		VClusterName:    VClusterName,
+		TargetNamespace: s.targetNamespace,
+	}
+}
+
 func SingleNamespaceHostName(name, namespace, suffix string) string {
 	if name == "" {
 		return ""
@@ -56,6 +116,16 @@ func (s *singleNamespace) HostNameCluster(name string) string {
 	if name == "" {
 		return ""
 	}
+
+	if s.clusterScopedNaming != nil {
+		hostName, err := s.clusterScopedNaming.HostName(s.templateValues(name, ""))
+		if err == nil {
+			return hostName
+		}
+
+		klog.Background().Error(err, "execute host naming template, falling back to default naming", "name", name)
+	}
+
 	return SafeConcatName("vcluster", name, "x", s.targetNamespace, "x", VClusterName)
 }
 
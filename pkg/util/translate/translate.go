@@ -2,8 +2,6 @@ package translate
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
@@ -160,7 +158,7 @@ func HostLabels(ctx *synccontext.SyncContext, vObj, pObj client.Object) map[stri
 }
 
 func HostAnnotations(vObj, pObj client.Object, excluded ...string) map[string]string {
-	excluded = append(excluded, NameAnnotation, UIDAnnotation, KindAnnotation, NamespaceAnnotation)
+	excluded = append(excluded, NameAnnotation, UIDAnnotation, KindAnnotation, NamespaceAnnotation, SyncErrorAnnotation, SyncErrorTimestampAnnotation, SyncPausedAnnotation, SyncedHostHashAnnotation, SyncedVirtualHashAnnotation, SyncedHostKeptAnnotation)
 	toAnnotations := map[string]string{}
 	if pObj != nil {
 		toAnnotations = pObj.GetAnnotations()
@@ -210,12 +208,7 @@ func GetOwnerReference(object client.Object) []metav1.OwnerReference {
 }
 
 func SafeConcatName(name ...string) string {
-	fullPath := strings.Join(name, "-")
-	if len(fullPath) > 63 {
-		digest := sha256.Sum256([]byte(fullPath))
-		return strings.ReplaceAll(fullPath[0:52]+"-"+hex.EncodeToString(digest[0:])[0:10], ".-", "-")
-	}
-	return fullPath
+	return SafeName(strings.Join(name, "-"), MaxNamespacedNameLength)
 }
 
 func UniqueSlice(stringSlice []string) []string {
@@ -352,7 +345,9 @@ func applyMaps(fromMap, toMap map[string]string, opts ApplyMapsOptions) (map[str
 	return retMap, managedKeysStr
 }
 
-func EnsureCRDFromPhysicalCluster(ctx context.Context, pConfig *rest.Config, vConfig *rest.Config, groupVersionKind schema.GroupVersionKind) (bool, bool, error) {
+// EnsureCRDFromPhysicalCluster copies the CRD of the given kind from the host cluster into the virtual cluster
+// if it does not exist there yet. Should be replaceable by unit tests.
+var EnsureCRDFromPhysicalCluster = func(ctx context.Context, pConfig *rest.Config, vConfig *rest.Config, groupVersionKind schema.GroupVersionKind) (bool, bool, error) {
 	var isClusterScoped, hasStatusSubresource bool
 
 	vClient, err := apiextensionsv1clientset.NewForConfig(vConfig)
@@ -1,6 +1,9 @@
 package translate
 
 import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/syncer/synccontext"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -10,10 +13,52 @@ var (
 	NameAnnotation      = "vcluster.loft.sh/object-name"
 	UIDAnnotation       = "vcluster.loft.sh/object-uid"
 	KindAnnotation      = "vcluster.loft.sh/object-kind"
+
+	// SyncErrorAnnotation holds the last error that occurred while syncing the virtual object
+	SyncErrorAnnotation = "vcluster.loft.sh/sync-error"
+	// SyncErrorTimestampAnnotation holds the time the last sync error occurred
+	SyncErrorTimestampAnnotation = "vcluster.loft.sh/sync-error-timestamp"
+
+	// SyncPausedAnnotation pauses the sync of a virtual object or all objects within a virtual namespace if set to "true"
+	SyncPausedAnnotation = "vcluster.loft.sh/sync-paused"
+
+	// SyncedHostHashAnnotation holds the hash of the host object after the last sync, it is used to detect changes
+	// made to the host object outside of vCluster
+	SyncedHostHashAnnotation = "vcluster.loft.sh/synced-host-hash"
+
+	// SyncedVirtualHashAnnotation holds the hash of the virtual object after the last sync and is set on the host object
+	SyncedVirtualHashAnnotation = "vcluster.loft.sh/synced-virtual-hash"
+
+	// SyncedHostKeptAnnotation is set on the host object if its changes made outside of vCluster were kept by the
+	// last sync, so they are not overwritten until the virtual object changes
+	SyncedHostKeptAnnotation = "vcluster.loft.sh/synced-host-kept"
 )
 
 var Default Translator = &singleNamespace{}
 
+// InitDefault sets the default translator for the host namespace(s) the given vCluster syncs to. In single
+// namespace mode the workload target namespace of the config is set as well.
+func InitDefault(vConfig *config.VirtualClusterConfig) error {
+	if vConfig.Experimental.MultiNamespaceMode.Enabled {
+		Default = NewMultiNamespaceTranslator(vConfig.WorkloadNamespace)
+		return nil
+	}
+
+	// ensure target namespace
+	vConfig.WorkloadTargetNamespace = vConfig.Experimental.SyncSettings.TargetNamespace
+	if vConfig.WorkloadTargetNamespace == "" {
+		vConfig.WorkloadTargetNamespace = vConfig.WorkloadNamespace
+	}
+
+	translator, err := NewSingleNamespaceTranslatorWithNaming(vConfig.WorkloadTargetNamespace, vConfig.Sync.HostNaming)
+	if err != nil {
+		return fmt.Errorf("create translator: %w", err)
+	}
+
+	Default = translator
+	return nil
+}
+
 type Translator interface {
 	// SingleNamespaceTarget signals if we sync all objects into a single namespace
 	SingleNamespaceTarget() bool